@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package amqperr provides the ability to extract the status code from AMQP errors
+// from the github.com/rabbitmq/amqp091-go package.
+package amqperr
+
+import (
+	"bursavich.dev/errcode"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the AMQP ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://www.rabbitmq.com/amqp-0-9-1-reference#constants
+
+var replyCodes = map[int]codes.Code{
+	amqp.ContentTooLarge:  codes.Unavailable, // 311; the client attempted to transfer content larger than the server could accept at the present time
+	amqp.NoRoute:          codes.Unavailable, // 312; the message could not be routed to a queue
+	amqp.NoConsumers:      codes.Unavailable, // 313; the message could not be delivered immediately to a consumer
+	amqp.ConnectionForced: codes.Unavailable, // 320; an operator intervened to close the connection for some reason
+
+	amqp.InvalidPath: codes.InvalidArgument, // 402; the client tried to work with an unknown virtual host
+	amqp.SyntaxError: codes.InvalidArgument, // 502; the sender sent a frame that contained illegal values for one or more fields
+
+	amqp.AccessRefused: codes.PermissionDenied, // 403; the client attempted to work with a server entity to which it has no access due to security settings
+
+	amqp.NotFound: codes.NotFound, // 404; the client attempted to work with a server entity that does not exist
+
+	amqp.ResourceLocked: codes.Aborted, // 405; the client attempted to work with a server entity to which it has no access because another client is working with it
+
+	amqp.PreconditionFailed: codes.FailedPrecondition, // 406; the client requested a method that was not allowed because some precondition failed
+	amqp.CommandInvalid:     codes.FailedPrecondition, // 503; the client sent an invalid sequence of frames
+	amqp.ChannelError:       codes.FailedPrecondition, // 504; the client attempted to work with a channel that had not been correctly opened
+	amqp.NotAllowed:         codes.FailedPrecondition, // 530; the client tried to work with some entity in a manner that is prohibited by the server
+
+	amqp.ResourceError: codes.ResourceExhausted, // 506; the server could not complete the method because it lacked sufficient resources
+
+	amqp.NotImplemented: codes.Unimplemented, // 540; the client tried to use functionality that is not implemented in the server
+
+	amqp.FrameError:      codes.Internal, // 501; the sender sent a malformed frame that the recipient could not decode
+	amqp.UnexpectedFrame: codes.Internal, // 505; the peer sent a frame that was not expected
+	amqp.InternalError:   codes.Internal, // 541; the server could not complete the method because of an internal error
+}
+
+// The client's sentinel errors reuse generic reply codes, so they're matched first.
+var errorCodes = map[error]codes.Code{
+	amqp.ErrClosed:      codes.Unavailable,
+	amqp.ErrChannelMax:  codes.ResourceExhausted,
+	amqp.ErrSASL:        codes.Unauthenticated,
+	amqp.ErrCredentials: codes.Unauthenticated,
+	amqp.ErrVhost:       codes.PermissionDenied,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an *amqp.Error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if code := sentinelCoder.ErrorCode(err); code != codes.Unknown {
+		return code
+	}
+	if e, ok := errcode.As[*amqp.Error](err); ok {
+		return ReplyCode(e.Code)
+	}
+	return codes.Unknown
+}
+
+// ReplyCode returns the gRPC code associated with the given AMQP reply code.
+// It returns codes.Unknown if the reply code isn't recognized.
+func ReplyCode(code int) codes.Code {
+	if c, ok := replyCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package amqperr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: &amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND"}, Want: codes.NotFound},
+		{Name: "access refused", Err: &amqp.Error{Code: amqp.AccessRefused, Reason: "ACCESS_REFUSED"}, Want: codes.PermissionDenied},
+		{Name: "resource locked", Err: &amqp.Error{Code: amqp.ResourceLocked, Reason: "RESOURCE_LOCKED"}, Want: codes.Aborted},
+		{Name: "connection forced", Err: &amqp.Error{Code: amqp.ConnectionForced, Reason: "CONNECTION_FORCED"}, Want: codes.Unavailable},
+		{Name: "unknown reply", Err: &amqp.Error{Code: 999, Reason: "FUTURE"}, Want: codes.Unknown},
+		{Name: "closed", Err: amqp.ErrClosed, Want: codes.Unavailable},
+		{Name: "credentials", Err: amqp.ErrCredentials, Want: codes.Unauthenticated},
+		{Name: "vhost", Err: amqp.ErrVhost, Want: codes.PermissionDenied},
+	})
+}
+
+func TestReplyCode(t *testing.T) {
+	for _, tt := range []struct {
+		code int
+		want codes.Code
+	}{
+		{amqp.PreconditionFailed, codes.FailedPrecondition},
+		{amqp.ResourceError, codes.ResourceExhausted},
+		{amqp.NotImplemented, codes.Unimplemented},
+		{amqp.InternalError, codes.Internal},
+		{999, codes.Unknown},
+	} {
+		if got := ReplyCode(tt.code); got != tt.want {
+			t.Errorf("ReplyCode(%d) = %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package dynamoerr provides the ability to extract the status code from DynamoDB errors
+// from the github.com/aws/aws-sdk-go-v2/service/dynamodb package.
+//
+// Errors that aren't specific to DynamoDB are handled by the generic AWS ErrorCoder.
+package dynamoerr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the DynamoDB ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Programming.Errors.html
+
+var dynamoCodes = map[string]codes.Code{
+	"IdempotentParameterMismatchException": codes.InvalidArgument,
+
+	"BackupNotFoundException":      codes.NotFound,
+	"GlobalTableNotFoundException": codes.NotFound,
+	"IndexNotFoundException":       codes.NotFound,
+	"TableNotFoundException":       codes.NotFound,
+
+	"DuplicateItemException":            codes.AlreadyExists,
+	"GlobalTableAlreadyExistsException": codes.AlreadyExists,
+	"TableAlreadyExistsException":       codes.AlreadyExists,
+
+	"ItemCollectionSizeLimitExceededException": codes.ResourceExhausted,
+
+	"BackupInUseException":                    codes.FailedPrecondition,
+	"ConditionalCheckFailedException":         codes.FailedPrecondition,
+	"PointInTimeRecoveryUnavailableException": codes.FailedPrecondition,
+	"TableInUseException":                     codes.FailedPrecondition,
+
+	"ReplicatedWriteConflictException": codes.Aborted,
+	"TransactionConflictException":     codes.Aborted,
+}
+
+// SEE: https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_TransactWriteItems.html#API_TransactWriteItems_Errors
+
+var reasonCodes = map[string]codes.Code{
+	"ValidationError":                 codes.InvalidArgument,
+	"ItemCollectionSizeLimitExceeded": codes.ResourceExhausted,
+	"ProvisionedThroughputExceeded":   codes.ResourceExhausted,
+	"ThrottlingError":                 codes.ResourceExhausted,
+	"ConditionalCheckFailed":          codes.FailedPrecondition,
+	"TransactionConflict":             codes.Aborted,
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a smithy.APIError with a known DynamoDB error code,
+// or any error known by the generic AWS ErrorCoder.
+//
+// A *types.TransactionCanceledException is mapped according to the
+// first of its cancellation reasons that is known, or Aborted otherwise.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*types.TransactionCanceledException](err); ok {
+		return cancellationCode(e.CancellationReasons)
+	}
+	if e, ok := errcode.As[smithy.APIError](err); ok {
+		if code, ok := dynamoCodes[e.ErrorCode()]; ok {
+			return code
+		}
+	}
+	return awserr.ErrorCode(err)
+}
+
+func cancellationCode(reasons []types.CancellationReason) codes.Code {
+	for _, r := range reasons {
+		if r.Code == nil {
+			continue
+		}
+		if code, ok := reasonCodes[*r.Code]; ok {
+			return code
+		}
+	}
+	return codes.Aborted
+}
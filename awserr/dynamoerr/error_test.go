@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package dynamoerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/awserr"
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want codes.Code
+	}{
+		{"ConditionalCheckFailedException", codes.FailedPrecondition},
+		{"TransactionConflictException", codes.Aborted},
+		{"TransactionInProgressException", codes.Aborted},
+		{"ThrottlingException", codes.ResourceExhausted},
+		{"SomethingElse", codes.Unknown},
+	}
+	for _, tt := range tests {
+		err := &smithy.GenericAPIError{Code: tt.code}
+		if got := ErrorCode(err); got != tt.want {
+			t.Errorf("ErrorCode(%s): got %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "conditional check", Err: &smithy.GenericAPIError{Code: "ConditionalCheckFailedException"}, Want: codes.FailedPrecondition},
+		{Name: "transaction conflict", Err: &smithy.GenericAPIError{Code: "TransactionConflictException"}, Want: codes.Aborted},
+		{Name: "throttling", Err: &smithy.GenericAPIError{Code: "ThrottlingException"}, Want: codes.ResourceExhausted},
+	})
+}
+
+func TestConsistentWithAWS(t *testing.T) {
+	// DynamoDB errors that are also known by the generic coder must have the same codes.
+	for name, want := range dynamoCodes {
+		if got := awserr.ErrorCode(&smithy.GenericAPIError{Code: name}); got != codes.Unknown && got != want {
+			t.Errorf("%s: awserr got %v; dynamoerr got %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package awserr provides the ability to extract the status code from AWS errors
+// from the github.com/aws/aws-sdk-go-v2 packages.
+//
+// Error codes that are common to many services are recognized here.
+// Service-specific codes are recognized by its subpackages.
+package awserr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the AWS ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://docs.aws.amazon.com/general/latest/gr/api-retries.html
+// SEE: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/errors-overview.html
+
+var apiCodes = map[string]codes.Code{
+	"IncompleteSignature":         codes.InvalidArgument,
+	"InvalidAction":               codes.InvalidArgument,
+	"InvalidInput":                codes.InvalidArgument,
+	"InvalidParameterCombination": codes.InvalidArgument,
+	"InvalidParameterException":   codes.InvalidArgument,
+	"InvalidParameterValue":       codes.InvalidArgument,
+	"InvalidQueryParameter":       codes.InvalidArgument,
+	"InvalidRequestException":     codes.InvalidArgument,
+	"MalformedQueryString":        codes.InvalidArgument,
+	"MissingAction":               codes.InvalidArgument,
+	"MissingParameter":            codes.InvalidArgument,
+	"SerializationException":      codes.InvalidArgument,
+	"ValidationError":             codes.InvalidArgument,
+	"ValidationException":         codes.InvalidArgument,
+
+	"RequestTimeout":          codes.DeadlineExceeded,
+	"RequestTimeoutException": codes.DeadlineExceeded,
+
+	"NoSuchEntity":              codes.NotFound,
+	"NotFound":                  codes.NotFound,
+	"NotFoundException":         codes.NotFound,
+	"ResourceNotFoundException": codes.NotFound,
+
+	"AlreadyExistsException":         codes.AlreadyExists,
+	"EntityAlreadyExists":            codes.AlreadyExists,
+	"ResourceAlreadyExistsException": codes.AlreadyExists,
+
+	"AccessDenied":          codes.PermissionDenied,
+	"AccessDeniedException": codes.PermissionDenied,
+	"Forbidden":             codes.PermissionDenied,
+	"OptInRequired":         codes.PermissionDenied,
+	"UnauthorizedOperation": codes.PermissionDenied,
+
+	"BandwidthLimitExceeded":                 codes.ResourceExhausted,
+	"EC2ThrottledException":                  codes.ResourceExhausted,
+	"LimitExceededException":                 codes.ResourceExhausted,
+	"PriorRequestNotComplete":                codes.ResourceExhausted,
+	"ProvisionedThroughputExceededException": codes.ResourceExhausted,
+	"RequestLimitExceeded":                   codes.ResourceExhausted,
+	"RequestThrottled":                       codes.ResourceExhausted,
+	"RequestThrottledException":              codes.ResourceExhausted,
+	"ServiceQuotaExceededException":          codes.ResourceExhausted,
+	"SlowDown":                               codes.ResourceExhausted,
+	"Throttling":                             codes.ResourceExhausted,
+	"ThrottlingException":                    codes.ResourceExhausted,
+	"TooManyRequestsException":               codes.ResourceExhausted,
+
+	"ResourceInUseException": codes.FailedPrecondition,
+
+	"ConflictException":              codes.Aborted,
+	"TransactionInProgressException": codes.Aborted,
+
+	"UnsupportedOperation":          codes.Unimplemented,
+	"UnsupportedOperationException": codes.Unimplemented,
+
+	"InternalError":        codes.Internal,
+	"InternalFailure":      codes.Internal,
+	"InternalServerError":  codes.Internal,
+	"InternalServiceError": codes.Internal,
+
+	"ServiceUnavailable":          codes.Unavailable,
+	"ServiceUnavailableException": codes.Unavailable,
+
+	"AuthFailure":                 codes.Unauthenticated,
+	"ExpiredToken":                codes.Unauthenticated,
+	"ExpiredTokenException":       codes.Unauthenticated,
+	"InvalidClientTokenId":        codes.Unauthenticated,
+	"InvalidSignatureException":   codes.Unauthenticated,
+	"MissingAuthenticationToken":  codes.Unauthenticated,
+	"RequestExpired":              codes.Unauthenticated,
+	"SignatureDoesNotMatch":       codes.Unauthenticated,
+	"UnrecognizedClientException": codes.Unauthenticated,
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a smithy.APIError with a known error code,
+// or an *awshttp.ResponseError with a known HTTP status code.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if _, ok := errcode.As[*smithy.CanceledError](err); ok {
+		return codes.Canceled
+	}
+	if e, ok := errcode.As[smithy.APIError](err); ok {
+		if code := APICode(e.ErrorCode()); code != codes.Unknown {
+			return code
+		}
+	}
+	if e, ok := errcode.As[*awshttp.ResponseError](err); ok {
+		return httperr.ToGRPC(e.HTTPStatusCode())
+	}
+	return codes.Unknown
+}
+
+// APICode returns the gRPC code associated with the given AWS API error code.
+func APICode(code string) codes.Code {
+	if c, ok := apiCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
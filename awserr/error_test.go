@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package awserr
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"google.golang.org/grpc/codes"
+)
+
+func responseError(status int, err error) error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: status}},
+			Err:      err,
+		},
+		RequestID: "req-1",
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "throttling", Err: &smithy.GenericAPIError{Code: "ThrottlingException"}, Want: codes.ResourceExhausted},
+		{Name: "too many requests", Err: &smithy.GenericAPIError{Code: "TooManyRequestsException"}, Want: codes.ResourceExhausted},
+		{Name: "access denied", Err: &smithy.GenericAPIError{Code: "AccessDenied"}, Want: codes.PermissionDenied},
+		{Name: "expired token", Err: &smithy.GenericAPIError{Code: "ExpiredToken"}, Want: codes.Unauthenticated},
+		{Name: "unrecognized client", Err: &smithy.GenericAPIError{Code: "UnrecognizedClientException"}, Want: codes.Unauthenticated},
+		{Name: "resource not found", Err: &smithy.GenericAPIError{Code: "ResourceNotFoundException"}, Want: codes.NotFound},
+		{Name: "canceled", Err: &smithy.CanceledError{Err: errors.New("context canceled")}, Want: codes.Canceled},
+		{
+			Name: "api error in response",
+			Err:  responseError(http.StatusBadRequest, &smithy.GenericAPIError{Code: "ValidationException"}),
+			Want: codes.InvalidArgument,
+		},
+		{
+			Name: "http status",
+			Err:  responseError(http.StatusServiceUnavailable, &smithy.GenericAPIError{Code: "SomethingElse"}),
+			Want: codes.Unavailable,
+		},
+		{Name: "unmapped", Err: &smithy.GenericAPIError{Code: "SomethingElse"}, Want: codes.Unknown},
+	})
+}
@@ -0,0 +1,21 @@
+module bursavich.dev/errcode/awserr
+
+go 1.24.0
+
+require (
+	bursavich.dev/errcode v0.2.0
+	github.com/aws/aws-sdk-go-v2 v1.42.1
+	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.53.5
+	github.com/aws/smithy-go v1.27.7
+	google.golang.org/grpc v1.72.2
+)
+
+require (
+	golang.org/x/net v0.35.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
+)
+
+replace bursavich.dev/errcode => ../
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package msgerr provides the ability to extract the status code from AWS messaging errors
+// from the SQS, SNS, and Kinesis packages of github.com/aws/aws-sdk-go-v2/service.
+//
+// Throttling is mapped to ResourceExhausted and may be retried with backoff,
+// while messages that are too large are mapped to InvalidArgument and shouldn't be.
+// Errors that aren't specific to these services are handled by the generic AWS ErrorCoder.
+package msgerr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/awserr"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the AWS messaging ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/CommonErrors.html
+// SEE: https://docs.aws.amazon.com/sns/latest/api/CommonErrors.html
+// SEE: https://docs.aws.amazon.com/kinesis/latest/APIReference/CommonErrors.html
+
+var messagingCodes = map[string]codes.Code{
+	// Amazon SQS
+	"AWS.SimpleQueueService.BatchRequestTooLong":          codes.InvalidArgument,
+	"AWS.SimpleQueueService.NonExistentQueue":             codes.NotFound,
+	"AWS.SimpleQueueService.PurgeQueueInProgress":         codes.FailedPrecondition,
+	"AWS.SimpleQueueService.QueueDeletedRecently":         codes.FailedPrecondition,
+	"AWS.SimpleQueueService.TooManyEntriesInBatchRequest": codes.InvalidArgument,
+	"AWS.SimpleQueueService.UnsupportedOperation":         codes.Unimplemented,
+	"BatchRequestTooLong":                                 codes.InvalidArgument,
+	"InvalidMessageContents":                              codes.InvalidArgument,
+	"KMS.AccessDeniedException":                           codes.PermissionDenied,
+	"KMS.DisabledException":                               codes.FailedPrecondition,
+	"KMS.InvalidStateException":                           codes.FailedPrecondition,
+	"KMS.NotFoundException":                               codes.FailedPrecondition,
+	"KMS.OptInRequired":                                   codes.FailedPrecondition,
+	"KMS.ThrottlingException":                             codes.ResourceExhausted,
+	"KmsAccessDenied":                                     codes.PermissionDenied,
+	"KmsDisabled":                                         codes.FailedPrecondition,
+	"KmsInvalidState":                                     codes.FailedPrecondition,
+	"KmsNotFound":                                         codes.FailedPrecondition,
+	"KmsOptInRequired":                                    codes.FailedPrecondition,
+	"KmsThrottled":                                        codes.ResourceExhausted,
+	"MessageNotInflight":                                  codes.FailedPrecondition,
+	"OverLimit":                                           codes.ResourceExhausted,
+	"PurgeQueueInProgress":                                codes.FailedPrecondition,
+	"QueueAlreadyExists":                                  codes.AlreadyExists,
+	"QueueDeletedRecently":                                codes.FailedPrecondition,
+	"QueueDoesNotExist":                                   codes.NotFound,
+	"QueueNameExists":                                     codes.AlreadyExists,
+	"ReceiptHandleIsInvalid":                              codes.InvalidArgument,
+	"TooManyEntriesInBatchRequest":                        codes.InvalidArgument,
+
+	// Amazon SNS
+	"AuthorizationError":          codes.PermissionDenied,
+	"EndpointDisabled":            codes.FailedPrecondition,
+	"InvalidParameter":            codes.InvalidArgument,
+	"KMSAccessDenied":             codes.PermissionDenied,
+	"KMSDisabled":                 codes.FailedPrecondition,
+	"KMSInvalidState":             codes.FailedPrecondition,
+	"KMSNotFound":                 codes.FailedPrecondition,
+	"KMSOptInRequired":            codes.FailedPrecondition,
+	"KMSThrottling":               codes.ResourceExhausted,
+	"ParameterValueInvalid":       codes.InvalidArgument,
+	"PlatformApplicationDisabled": codes.FailedPrecondition,
+	"SubscriptionLimitExceeded":   codes.ResourceExhausted,
+	"Throttled":                   codes.ResourceExhausted,
+	"TopicLimitExceeded":          codes.ResourceExhausted,
+
+	// Amazon Kinesis
+	"ExpiredIteratorException":  codes.FailedPrecondition,
+	"ExpiredNextTokenException": codes.InvalidArgument,
+	"KMSAccessDeniedException":  codes.PermissionDenied,
+	"KMSDisabledException":      codes.FailedPrecondition,
+	"KMSInvalidStateException":  codes.FailedPrecondition,
+	"KMSNotFoundException":      codes.FailedPrecondition,
+	"KMSThrottlingException":    codes.ResourceExhausted,
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a smithy.APIError with a known SQS, SNS, or Kinesis error code,
+// or any error known by the generic AWS ErrorCoder.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[smithy.APIError](err); ok {
+		if code, ok := messagingCodes[e.ErrorCode()]; ok {
+			return code
+		}
+	}
+	return awserr.ErrorCode(err)
+}
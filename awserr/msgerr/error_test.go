@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package msgerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "sqs queue does not exist", Err: &smithy.GenericAPIError{Code: "AWS.SimpleQueueService.NonExistentQueue"}, Want: codes.NotFound},
+		{Name: "sqs message too large", Err: &smithy.GenericAPIError{Code: "BatchRequestTooLong"}, Want: codes.InvalidArgument},
+		{Name: "sqs kms access", Err: &smithy.GenericAPIError{Code: "KMS.AccessDeniedException"}, Want: codes.PermissionDenied},
+		{Name: "sns throttled", Err: &smithy.GenericAPIError{Code: "Throttled"}, Want: codes.ResourceExhausted},
+		{Name: "sns kms throttling", Err: &smithy.GenericAPIError{Code: "KMSThrottling"}, Want: codes.ResourceExhausted},
+		{Name: "kinesis kms access", Err: &smithy.GenericAPIError{Code: "KMSAccessDeniedException"}, Want: codes.PermissionDenied},
+		{Name: "kinesis throughput", Err: &smithy.GenericAPIError{Code: "ProvisionedThroughputExceededException"}, Want: codes.ResourceExhausted},
+	})
+}
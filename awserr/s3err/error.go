@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package s3err provides the ability to extract the status code from S3 errors
+// from the github.com/aws/aws-sdk-go-v2/service/s3 package.
+//
+// The typed errors of the S3 client, such as *types.NoSuchKey, implement
+// smithy.APIError and are recognized by their error codes. Errors that aren't
+// specific to S3 are handled by the generic AWS ErrorCoder.
+package s3err
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/awserr"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the S3 ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html#ErrorCodeList
+
+var s3Codes = map[string]codes.Code{
+	"BadDigest":           codes.InvalidArgument, // The Content-MD5 or checksum value that you specified did not match what the server received.
+	"EntityTooLarge":      codes.InvalidArgument, // Your proposed upload exceeds the maximum allowed object size.
+	"EntityTooSmall":      codes.InvalidArgument, // Your proposed upload is smaller than the minimum allowed object size.
+	"InvalidArgument":     codes.InvalidArgument, // Invalid Argument
+	"InvalidBucketName":   codes.InvalidArgument, // The specified bucket is not valid.
+	"InvalidDigest":       codes.InvalidArgument, // The Content-MD5 or checksum value that you specified is not valid.
+	"InvalidPart":         codes.InvalidArgument, // One or more of the specified parts could not be found.
+	"InvalidPartOrder":    codes.InvalidArgument, // The list of parts was not in ascending order.
+	"KeyTooLongError":     codes.InvalidArgument, // Your key is too long.
+	"MalformedXML":        codes.InvalidArgument, // The XML that you provided was not well formed or did not validate against our published schema.
+	"MetadataTooLarge":    codes.InvalidArgument, // Your metadata headers exceed the maximum allowed metadata size.
+	"InvalidStorageClass": codes.InvalidArgument, // The storage class that you specified is not valid.
+
+	"NoSuchBucket":                 codes.NotFound, // The specified bucket does not exist.
+	"NoSuchBucketPolicy":           codes.NotFound, // The specified bucket does not have a bucket policy.
+	"NoSuchCORSConfiguration":      codes.NotFound, // The specified bucket does not have a CORS configuration.
+	"NoSuchKey":                    codes.NotFound, // The specified key does not exist.
+	"NoSuchLifecycleConfiguration": codes.NotFound, // The specified lifecycle configuration does not exist.
+	"NoSuchTagSet":                 codes.NotFound, // The specified tag does not exist.
+	"NoSuchUpload":                 codes.NotFound, // The specified multipart upload does not exist.
+	"NoSuchVersion":                codes.NotFound, // The version ID specified in the request does not match an existing version.
+	"NotFound":                     codes.NotFound, // Not Found (HEAD requests)
+
+	"BucketAlreadyExists":     codes.AlreadyExists, // The requested bucket name is not available.
+	"BucketAlreadyOwnedByYou": codes.AlreadyExists, // The bucket that you tried to create already exists, and you own it.
+
+	"AccountProblem":    codes.PermissionDenied, // There is a problem with your AWS account that prevents the operation from completing successfully.
+	"AllAccessDisabled": codes.PermissionDenied, // All access to this Amazon S3 resource has been disabled.
+
+	"TooManyBuckets": codes.ResourceExhausted, // You have attempted to create more buckets than are allowed.
+	"SlowDown":       codes.ResourceExhausted, // Please reduce your request rate.
+
+	"BucketNotEmpty":     codes.FailedPrecondition, // The bucket that you tried to delete is not empty.
+	"InvalidObjectState": codes.FailedPrecondition, // The operation is not valid for the current state of the object.
+	"PreconditionFailed": codes.FailedPrecondition, // At least one of the preconditions that you specified did not hold.
+
+	"OperationAborted": codes.Aborted, // A conflicting conditional operation is currently in progress against this resource.
+
+	"InvalidRange": codes.OutOfRange, // The requested range cannot be satisfied.
+
+	"NotImplemented": codes.Unimplemented, // A header that you provided implies functionality that is not implemented.
+
+	"InvalidAccessKeyId": codes.Unauthenticated, // The AWS access key ID that you provided does not exist in our records.
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a smithy.APIError with a known S3 error code,
+// or any error known by the generic AWS ErrorCoder.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[smithy.APIError](err); ok {
+		if code, ok := s3Codes[e.ErrorCode()]; ok {
+			return code
+		}
+	}
+	return awserr.ErrorCode(err)
+}
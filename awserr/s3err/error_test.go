@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package s3err
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/aws/smithy-go"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "no such key", Err: &smithy.GenericAPIError{Code: "NoSuchKey"}, Want: codes.NotFound},
+		{Name: "no such bucket", Err: &smithy.GenericAPIError{Code: "NoSuchBucket"}, Want: codes.NotFound},
+		{Name: "bucket already owned", Err: &smithy.GenericAPIError{Code: "BucketAlreadyOwnedByYou"}, Want: codes.AlreadyExists},
+		{Name: "bucket already exists", Err: &smithy.GenericAPIError{Code: "BucketAlreadyExists"}, Want: codes.AlreadyExists},
+		{Name: "precondition failed", Err: &smithy.GenericAPIError{Code: "PreconditionFailed"}, Want: codes.FailedPrecondition},
+		{Name: "slow down", Err: &smithy.GenericAPIError{Code: "SlowDown"}, Want: codes.ResourceExhausted},
+		{Name: "invalid range", Err: &smithy.GenericAPIError{Code: "InvalidRange"}, Want: codes.OutOfRange},
+		{Name: "generic", Err: &smithy.GenericAPIError{Code: "ExpiredToken"}, Want: codes.Unauthenticated},
+	})
+}
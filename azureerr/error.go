@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package azureerr provides the ability to extract the status code from Azure errors
+// from the github.com/Azure/azure-sdk-for-go/sdk/azcore package.
+package azureerr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Azure ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://learn.microsoft.com/en-us/rest/api/storageservices/common-rest-api-error-codes
+// SEE: https://learn.microsoft.com/en-us/rest/api/storageservices/blob-service-error-codes
+// SEE: https://learn.microsoft.com/en-us/azure/azure-resource-manager/troubleshooting/common-deployment-errors
+
+var azureCodes = map[string]codes.Code{
+	"InvalidHeaderValue":         codes.InvalidArgument, // The value provided for one of the HTTP headers was not in the correct format.
+	"InvalidInput":               codes.InvalidArgument, // One of the request inputs is not valid.
+	"InvalidQueryParameterValue": codes.InvalidArgument, // An invalid value was specified for one of the query parameters in the request URI.
+	"InvalidResourceName":        codes.InvalidArgument, // The specified resource name contains invalid characters.
+	"InvalidUri":                 codes.InvalidArgument, // The requested URI does not represent any resource on the server.
+	"MissingRequiredHeader":      codes.InvalidArgument, // A required HTTP header was not specified.
+	"RequestBodyTooLarge":        codes.InvalidArgument, // The size of the request body exceeds the maximum size permitted.
+
+	"OperationTimedOut": codes.DeadlineExceeded, // The operation could not be completed within the permitted time.
+
+	"BlobNotFound":          codes.NotFound, // The specified blob does not exist.
+	"ContainerNotFound":     codes.NotFound, // The specified container does not exist.
+	"NotFound":              codes.NotFound,
+	"QueueNotFound":         codes.NotFound, // The specified queue does not exist.
+	"ResourceGroupNotFound": codes.NotFound, // Resource group '%s' could not be found.
+	"ResourceNotFound":      codes.NotFound, // The specified resource does not exist.
+	"ShareNotFound":         codes.NotFound, // The specified share does not exist.
+
+	"BlobAlreadyExists":      codes.AlreadyExists, // The specified blob already exists.
+	"ContainerAlreadyExists": codes.AlreadyExists, // The specified container already exists.
+	"QueueAlreadyExists":     codes.AlreadyExists, // The specified queue already exists.
+	"ResourceAlreadyExists":  codes.AlreadyExists, // The specified resource already exists.
+	"ShareAlreadyExists":     codes.AlreadyExists, // The specified share already exists.
+
+	"AccountIsDisabled":               codes.PermissionDenied, // The specified account is disabled.
+	"AuthorizationFailed":             codes.PermissionDenied, // The client does not have authorization to perform the action.
+	"AuthorizationFailure":            codes.PermissionDenied, // This request is not authorized to perform this operation.
+	"AuthorizationPermissionMismatch": codes.PermissionDenied, // This request is not authorized to perform this operation using this permission.
+	"AuthorizationSourceIPMismatch":   codes.PermissionDenied, // This request is not authorized to perform this operation using this source IP.
+	"InsufficientAccountPermissions":  codes.PermissionDenied, // The account being accessed does not have sufficient permissions to execute this operation.
+
+	"QuotaExceeded":                 codes.ResourceExhausted, // Operation results in exceeding quota limits.
+	"SubscriptionRequestsThrottled": codes.ResourceExhausted, // Number of requests for subscription exceeded the limit.
+	"TooManyRequests":               codes.ResourceExhausted,
+
+	"BlobArchived":                     codes.FailedPrecondition, // This operation is not permitted on an archived blob.
+	"ConditionNotMet":                  codes.FailedPrecondition, // The condition specified using HTTP conditional header(s) is not met.
+	"ContainerBeingDeleted":            codes.FailedPrecondition, // The specified container is being deleted.
+	"ContainerDisabled":                codes.FailedPrecondition, // The specified container has been disabled by the administrator.
+	"LeaseAlreadyPresent":              codes.FailedPrecondition, // There is already a lease present.
+	"LeaseIdMismatchWithBlobOperation": codes.FailedPrecondition, // The lease ID specified did not match the lease ID for the blob.
+	"LeaseIdMissing":                   codes.FailedPrecondition, // There is currently a lease on the blob and no lease ID was specified in the request.
+	"LeaseNotPresentWithBlobOperation": codes.FailedPrecondition, // There is currently no lease on the blob.
+	"SourceConditionNotMet":            codes.FailedPrecondition, // The source condition specified using HTTP conditional header(s) is not met.
+	"TargetConditionNotMet":            codes.FailedPrecondition, // The target condition specified using HTTP conditional header(s) is not met.
+
+	"InvalidRange": codes.OutOfRange, // The range specified is invalid for the current size of the resource.
+
+	"InternalError": codes.Internal, // The server encountered an internal error.
+
+	"ServerBusy": codes.Unavailable, // The server is currently unable to receive requests.
+
+	"AuthenticationFailed":        codes.Unauthenticated, // Server failed to authenticate the request.
+	"ExpiredAuthenticationToken":  codes.Unauthenticated, // The access token expiry UTC time is earlier than current UTC time.
+	"InvalidAuthenticationToken":  codes.Unauthenticated, // The received access token is not valid.
+	"NoAuthenticationInformation": codes.Unauthenticated, // Server failed to authenticate the request.
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an *azcore.ResponseError. Unknown error codes
+// fall back to the HTTP status code.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*azcore.ResponseError](err); ok {
+		if code, ok := azureCodes[e.ErrorCode]; ok {
+			return code
+		}
+		return httperr.ToGRPC(e.StatusCode)
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package azureerr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"google.golang.org/grpc/codes"
+)
+
+func responseError(status int, code string) error {
+	return &azcore.ResponseError{
+		ErrorCode:  code,
+		StatusCode: status,
+		RawResponse: &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Request:    httptest.NewRequest(http.MethodGet, "https://example.blob.core.windows.net/c/b", nil),
+		},
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "blob not found", Err: responseError(http.StatusNotFound, "BlobNotFound"), Want: codes.NotFound},
+		{Name: "container already exists", Err: responseError(http.StatusConflict, "ContainerAlreadyExists"), Want: codes.AlreadyExists},
+		{Name: "authorization failure", Err: responseError(http.StatusForbidden, "AuthorizationFailure"), Want: codes.PermissionDenied},
+		{Name: "server busy", Err: responseError(http.StatusServiceUnavailable, "ServerBusy"), Want: codes.Unavailable},
+		{Name: "condition not met", Err: responseError(http.StatusPreconditionFailed, "ConditionNotMet"), Want: codes.FailedPrecondition},
+		{Name: "http status", Err: responseError(http.StatusTooManyRequests, "SomethingElse"), Want: codes.ResourceExhausted},
+	})
+}
@@ -0,0 +1,20 @@
+module bursavich.dev/errcode/azureerr
+
+go 1.24.0
+
+require (
+	bursavich.dev/errcode v0.2.0
+	github.com/Azure/azure-sdk-for-go/sdk/azcore v1.21.0
+	google.golang.org/grpc v1.72.2
+)
+
+require (
+	github.com/Azure/azure-sdk-for-go/sdk/internal v1.11.2 // indirect
+	golang.org/x/net v0.49.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	golang.org/x/text v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
+)
+
+replace bursavich.dev/errcode => ../
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package badgererr provides the ability to extract the status code from BadgerDB errors
+// from the github.com/dgraph-io/badger/v4 package.
+package badgererr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/dgraph-io/badger/v4"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the BadgerDB ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	badger.ErrKeyNotFound: codes.NotFound,
+
+	badger.ErrEmptyKey:       codes.InvalidArgument,
+	badger.ErrInvalidKey:     codes.InvalidArgument,
+	badger.ErrBannedKey:      codes.InvalidArgument,
+	badger.ErrInvalidRequest: codes.InvalidArgument,
+
+	badger.ErrTxnTooBig: codes.ResourceExhausted,
+
+	badger.ErrReadOnlyTxn:   codes.FailedPrecondition,
+	badger.ErrDiscardedTxn:  codes.FailedPrecondition,
+	badger.ErrManagedTxn:    codes.FailedPrecondition,
+	badger.ErrNamespaceMode: codes.FailedPrecondition,
+	badger.ErrNoRewrite:     codes.FailedPrecondition,
+	badger.ErrRejected:      codes.FailedPrecondition,
+
+	badger.ErrConflict: codes.Aborted,
+
+	badger.ErrDBClosed:      codes.Unavailable,
+	badger.ErrBlockedWrites: codes.Unavailable,
+
+	badger.ErrEncryptionKeyMismatch: codes.PermissionDenied,
+
+	badger.ErrTruncateNeeded: codes.DataLoss,
+	badger.ErrInvalidDump:    codes.DataLoss,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known BadgerDB error.
+func ErrorCode(err error) codes.Code {
+	return sentinelCoder.ErrorCode(err)
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package badgererr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/dgraph-io/badger/v4"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "key not found", Err: badger.ErrKeyNotFound, Want: codes.NotFound},
+		{Name: "conflict", Err: badger.ErrConflict, Want: codes.Aborted},
+		{Name: "discarded txn", Err: badger.ErrDiscardedTxn, Want: codes.FailedPrecondition},
+		{Name: "txn too big", Err: badger.ErrTxnTooBig, Want: codes.ResourceExhausted},
+		{Name: "db closed", Err: badger.ErrDBClosed, Want: codes.Unavailable},
+	})
+}
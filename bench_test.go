@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+var benchCoder = Compact(
+	CodedErrorCoder(),
+	ContextErrorCoder(),
+	FileSystemErrorCoder(),
+	TransientErrorCoder(),
+	IOErrorCoder(),
+)
+
+var benchErrors = []struct {
+	name string
+	err  error
+	code codes.Code
+}{
+	{"nil", nil, codes.OK},
+	{"coded", New(codes.NotFound, errors.New("missing")), codes.NotFound},
+	{"wrapped coded", fmt.Errorf("get: %w", New(codes.NotFound, errors.New("missing"))), codes.NotFound},
+	{"context", context.Canceled, codes.Canceled},
+	{"wrapped context", fmt.Errorf("get: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+	{"fs", fmt.Errorf("open: %w", fs.ErrNotExist), codes.NotFound},
+	{"unknown", fmt.Errorf("get: %w", errors.New("boom")), codes.Unknown},
+}
+
+func BenchmarkErrorCode(b *testing.B) {
+	for _, bb := range benchErrors {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if code := benchCoder.ErrorCode(bb.err); code != bb.code {
+					b.Fatalf("ErrorCode: got %v; want %v", code, bb.code)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMapErrors(b *testing.B) {
+	coder := MapErrors(map[error]codes.Code{
+		fs.ErrNotExist:   codes.NotFound,
+		fs.ErrExist:      codes.AlreadyExists,
+		fs.ErrClosed:     codes.FailedPrecondition,
+		context.Canceled: codes.Canceled,
+	})
+	err := fmt.Errorf("open: %w", fs.ErrClosed)
+	b.ReportAllocs()
+	for b.Loop() {
+		if code := coder.ErrorCode(err); code != codes.FailedPrecondition {
+			b.Fatalf("ErrorCode: got %v; want %v", code, codes.FailedPrecondition)
+		}
+	}
+}
+
+func TestErrorCodeAllocs(t *testing.T) {
+	for _, tt := range benchErrors {
+		t.Run(tt.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() { benchCoder.ErrorCode(tt.err) })
+			if allocs != 0 {
+				t.Errorf("ErrorCode allocated %v times; want 0", allocs)
+			}
+		})
+	}
+}
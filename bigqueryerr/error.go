@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package bigqueryerr provides the ability to extract the status code from BigQuery errors
+// from the cloud.google.com/go/bigquery package.
+package bigqueryerr
+
+import (
+	"bursavich.dev/errcode"
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the BigQuery ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://cloud.google.com/bigquery/docs/error-messages
+
+var reasonCodes = map[string]codes.Code{
+	"stopped": codes.Canceled, // The job was canceled.
+
+	"invalid":      codes.InvalidArgument, // An invalid input other than an invalid query.
+	"invalidQuery": codes.InvalidArgument, // The query is invalid.
+	"invalidUser":  codes.InvalidArgument, // The user-defined function is invalid.
+
+	"timeout": codes.DeadlineExceeded, // The job timed out.
+
+	"notFound": codes.NotFound, // The referenced resource could not be found.
+
+	"duplicate": codes.AlreadyExists, // The resource already exists.
+
+	"accessDenied": codes.PermissionDenied, // The caller doesn't have permission.
+	"blocked":      codes.PermissionDenied, // BigQuery has temporarily denylisted the operation.
+
+	"billingTierLimitExceeded": codes.ResourceExhausted, // The query exceeded the billing tier.
+	"jobRateLimitExceeded":     codes.ResourceExhausted, // The job exceeded rate limits.
+	"quotaExceeded":            codes.ResourceExhausted, // The project exceeded a quota.
+	"rateLimitExceeded":        codes.ResourceExhausted, // The project exceeded a rate limit.
+	"resourcesExceeded":        codes.ResourceExhausted, // The query used too many resources.
+	"responseTooLarge":         codes.ResourceExhausted, // The query's results are larger than the maximum response size.
+
+	"billingNotEnabled": codes.FailedPrecondition, // Billing isn't enabled for the project.
+	"resourceInUse":     codes.FailedPrecondition, // The resource is in use.
+
+	"notImplemented": codes.Unimplemented, // The method isn't implemented.
+
+	"internalError":    codes.Internal, // An internal error occurred.
+	"jobInternalError": codes.Internal, // The job failed due to an internal error.
+
+	"backendError":     codes.Unavailable, // The service is temporarily unavailable.
+	"jobBackendError":  codes.Unavailable, // The job failed due to a transient backend error.
+	"tableUnavailable": codes.Unavailable, // The table is temporarily unavailable.
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *bigquery.Error or a *googleapi.Error with a known reason.
+//
+// For the errors of a job status or an insertion, the first known reason is used.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*bigquery.Error](err); ok {
+		if code, ok := reasonCodes[e.Reason]; ok {
+			return code
+		}
+	}
+	if e, ok := errcode.As[bigquery.PutMultiError](err); ok {
+		for _, row := range e {
+			if code := multiErrorCode(row.Errors); code != codes.Unknown {
+				return code
+			}
+		}
+	}
+	if e, ok := errcode.As[bigquery.MultiError](err); ok {
+		if code := multiErrorCode(e); code != codes.Unknown {
+			return code
+		}
+	}
+	if e, ok := errcode.As[*googleapi.Error](err); ok {
+		for _, item := range e.Errors {
+			if code, ok := reasonCodes[item.Reason]; ok {
+				return code
+			}
+		}
+	}
+	return codes.Unknown
+}
+
+// JobStatusCode returns the gRPC code associated with the given job status.
+// It returns OK if the job hasn't failed.
+func JobStatusCode(s *bigquery.JobStatus) codes.Code {
+	if s == nil || s.Err() == nil {
+		return codes.OK
+	}
+	if code := ErrorCode(s.Err()); code != codes.Unknown {
+		return code
+	}
+	for _, e := range s.Errors {
+		if code, ok := reasonCodes[e.Reason]; ok {
+			return code
+		}
+	}
+	return codes.Unknown
+}
+
+func multiErrorCode(errs bigquery.MultiError) codes.Code {
+	for _, err := range errs {
+		if code := ErrorCode(err); code != codes.Unknown {
+			return code
+		}
+	}
+	return codes.Unknown
+}
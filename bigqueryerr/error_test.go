@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package bigqueryerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: &bigquery.Error{Reason: "notFound"}, Want: codes.NotFound},
+		{Name: "duplicate", Err: &bigquery.Error{Reason: "duplicate"}, Want: codes.AlreadyExists},
+		{Name: "rate limit", Err: &bigquery.Error{Reason: "rateLimitExceeded"}, Want: codes.ResourceExhausted},
+		{Name: "quota", Err: &bigquery.Error{Reason: "quotaExceeded"}, Want: codes.ResourceExhausted},
+		{Name: "access denied", Err: &bigquery.Error{Reason: "accessDenied"}, Want: codes.PermissionDenied},
+		{Name: "invalid query", Err: &bigquery.Error{Reason: "invalidQuery"}, Want: codes.InvalidArgument},
+		{Name: "backend", Err: &bigquery.Error{Reason: "backendError"}, Want: codes.Unavailable},
+		{
+			Name: "multi",
+			Err:  bigquery.MultiError{&bigquery.Error{Reason: "bogus"}, &bigquery.Error{Reason: "invalid"}},
+			Want: codes.InvalidArgument,
+		},
+		{
+			Name: "put multi",
+			Err:  bigquery.PutMultiError{{RowIndex: 3, Errors: bigquery.MultiError{&bigquery.Error{Reason: "invalid"}}}},
+			Want: codes.InvalidArgument,
+		},
+		{
+			Name: "googleapi",
+			Err:  &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}},
+			Want: codes.ResourceExhausted,
+		},
+	})
+}
+
+func TestJobStatusCode(t *testing.T) {
+	tests := []struct {
+		status *bigquery.JobStatus
+		want   codes.Code
+	}{
+		{nil, codes.OK},
+		{&bigquery.JobStatus{State: bigquery.Done}, codes.OK},
+	}
+	for _, tt := range tests {
+		if got := JobStatusCode(tt.status); got != tt.want {
+			t.Errorf("JobStatusCode(%+v): got %v; want %v", tt.status, got, tt.want)
+		}
+	}
+}
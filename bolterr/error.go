@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package bolterr provides the ability to extract the status code from bbolt errors
+// from the go.etcd.io/bbolt package.
+package bolterr
+
+import (
+	"bursavich.dev/errcode"
+	berrors "go.etcd.io/bbolt/errors"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the bbolt ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	berrors.ErrBucketNotFound: codes.NotFound,
+
+	berrors.ErrBucketExists: codes.AlreadyExists,
+
+	berrors.ErrBucketNameRequired: codes.InvalidArgument,
+	berrors.ErrKeyRequired:        codes.InvalidArgument,
+	berrors.ErrKeyTooLarge:        codes.InvalidArgument,
+	berrors.ErrValueTooLarge:      codes.InvalidArgument,
+	berrors.ErrSameBuckets:        codes.InvalidArgument,
+	berrors.ErrDifferentDB:        codes.InvalidArgument,
+
+	berrors.ErrTxNotWritable:      codes.FailedPrecondition,
+	berrors.ErrTxClosed:           codes.FailedPrecondition,
+	berrors.ErrDatabaseReadOnly:   codes.FailedPrecondition,
+	berrors.ErrIncompatibleValue:  codes.FailedPrecondition,
+	berrors.ErrFreePagesNotLoaded: codes.FailedPrecondition,
+
+	berrors.ErrTimeout: codes.DeadlineExceeded,
+
+	berrors.ErrDatabaseNotOpen: codes.Unavailable,
+
+	berrors.ErrInvalid:         codes.DataLoss,
+	berrors.ErrInvalidMapping:  codes.DataLoss,
+	berrors.ErrChecksum:        codes.DataLoss,
+	berrors.ErrVersionMismatch: codes.DataLoss,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known bbolt error.
+func ErrorCode(err error) codes.Code {
+	return sentinelCoder.ErrorCode(err)
+}
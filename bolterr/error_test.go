@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package bolterr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	berrors "go.etcd.io/bbolt/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "bucket not found", Err: berrors.ErrBucketNotFound, Want: codes.NotFound},
+		{Name: "bucket exists", Err: berrors.ErrBucketExists, Want: codes.AlreadyExists},
+		{Name: "tx not writable", Err: berrors.ErrTxNotWritable, Want: codes.FailedPrecondition},
+		{Name: "database not open", Err: berrors.ErrDatabaseNotOpen, Want: codes.Unavailable},
+		{Name: "timeout", Err: berrors.ErrTimeout, Want: codes.DeadlineExceeded},
+		{Name: "checksum", Err: berrors.ErrChecksum, Want: codes.DataLoss},
+	})
+}
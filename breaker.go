@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+// BreakerSuccess returns a predicate for circuit breakers that reports whether
+// an error counts as a success of the protected dependency. Only errors whose
+// codes are ServerErrors or Transient count as failures, so errors caused by
+// clients, such as InvalidArgument or NotFound, don't trip the breaker.
+//
+// It may be used with breakers that distinguish successes from failures by
+// errors, such as the IsSuccessful setting of github.com/sony/gobreaker.
+func BreakerSuccess(coders ...ErrorCoder) func(err error) bool {
+	coder := Compact(coders...)
+	return func(err error) bool {
+		return !IsServerFault(err, coder)
+	}
+}
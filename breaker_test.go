@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestBreakerSuccess(t *testing.T) {
+	success := BreakerSuccess(CodedErrorCoder())
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, true},
+		{New(codes.InvalidArgument, errors.New("bad")), true},
+		{New(codes.Unavailable, errors.New("down")), false},
+		{errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := success(tt.err); got != tt.want {
+			t.Errorf("BreakerSuccess(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
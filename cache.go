@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A CacheOption configures a cached ErrorCoder.
+type CacheOption func(*cachedErrorCoder)
+
+// WithUncachedTypes returns a CacheOption that opts the dynamic types of the
+// given errors out of caching, because their codes vary per value. For example,
+// a *pgconn.PgError's code depends on its SQLSTATE:
+//
+//	errcode.Cached(coder, errcode.WithUncachedTypes((*pgconn.PgError)(nil)))
+func WithUncachedTypes(errs ...error) CacheOption {
+	return func(c *cachedErrorCoder) {
+		for _, err := range errs {
+			c.uncached[reflect.TypeOf(err)] = true
+		}
+	}
+}
+
+// Cached returns an ErrorCoder that memoizes the codes resolved by the coder,
+// keyed by the dynamic types of the errors. It's intended for hot paths that
+// classify errors of the same types many times with long lists of coders that
+// classify errors by their types.
+//
+// The code of an error is only cached if it's assumed to be determined by the
+// error's type. It isn't cached if the error's type is neither a pointer nor a
+// struct, such as syscall.Errno, if it's the type of errors created by errors.New,
+// or if it has any of the following methods, which indicate that its code
+// depends on its value or on the errors it wraps:
+//
+//	Unwrap() error
+//	Unwrap() []error
+//	Is(error) bool
+//	As(any) bool
+//	Code() codes.Code
+//	GRPCStatus() *status.Status
+//	HTTPCode() int
+//	HTTPStatusCode() int
+//	StatusCode() int
+//	ErrorCode() string
+//	SQLState() string
+//	Timeout() bool
+//	Temporary() bool
+//
+// Other types whose codes vary per value, such as database errors with vendor
+// codes, errors matched by their messages, or pointer sentinels matched by
+// MapErrors, must be opted out with WithUncachedTypes.
+func Cached(coder ErrorCoder, opts ...CacheOption) ErrorCoder {
+	c := &cachedErrorCoder{
+		coder:    coder,
+		uncached: map[reflect.Type]bool{errorStringType: true},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+var errorStringType = reflect.TypeOf(errors.New(""))
+
+type cachedErrorCoder struct {
+	coder    ErrorCoder
+	uncached map[reflect.Type]bool
+	codes    sync.Map // map[reflect.Type]codes.Code
+}
+
+func (c *cachedErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	t := reflect.TypeOf(err)
+	if !c.cacheable(err, t) {
+		return c.coder.ErrorCode(err)
+	}
+	if v, ok := c.codes.Load(t); ok {
+		return v.(codes.Code)
+	}
+	code := c.coder.ErrorCode(err)
+	c.codes.Store(t, code)
+	return code
+}
+
+func (c *cachedErrorCoder) cacheable(err error, t reflect.Type) bool {
+	switch err.(type) {
+	case interface{ Unwrap() error },
+		interface{ Unwrap() []error },
+		interface{ Is(error) bool },
+		interface{ As(any) bool },
+		interface{ Code() codes.Code },
+		interface{ GRPCStatus() *status.Status },
+		interface{ HTTPCode() int },
+		interface{ HTTPStatusCode() int },
+		interface{ StatusCode() int },
+		interface{ ErrorCode() string },
+		interface{ SQLState() string },
+		interface{ Timeout() bool },
+		interface{ Temporary() bool }:
+		return false
+	}
+	if k := t.Kind(); k != reflect.Pointer && k != reflect.Struct {
+		return false
+	}
+	return !c.uncached[t]
+}
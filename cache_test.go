@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type leafError struct{ msg string }
+
+func (e *leafError) Error() string { return e.msg }
+
+type numberError int
+
+func (e numberError) Error() string { return fmt.Sprint(int(e)) }
+
+type varyingError struct{ code codes.Code }
+
+func (e *varyingError) Error() string { return "varying" }
+
+// statusCodeError is keyed by value, like an *eserr.Error.
+type statusCodeError struct{ status int }
+
+func (e *statusCodeError) Error() string { return http.StatusText(e.status) }
+func (e *statusCodeError) HTTPCode() int { return e.status }
+
+// countingCoder counts calls and resolves codes by type and value.
+type countingCoder struct{ calls int }
+
+func (c *countingCoder) ErrorCode(err error) codes.Code {
+	c.calls++
+	switch e := err.(type) {
+	case nil:
+		return codes.OK
+	case *leafError:
+		return codes.NotFound
+	case numberError:
+		if e == 1 {
+			return codes.Internal
+		}
+	case *varyingError:
+		return e.code
+	case *statusCodeError:
+		return codes.Code(e.status)
+	case interface{ GRPCStatus() *status.Status }:
+		return e.GRPCStatus().Code()
+	}
+	if err.Error() == "leaf" {
+		return codes.NotFound
+	}
+	return codes.Unknown
+}
+
+func TestCached(t *testing.T) {
+	tests := []struct {
+		name  string
+		errs  []error
+		want  []codes.Code
+		calls int
+	}{
+		{
+			name:  "cached",
+			errs:  []error{&leafError{"leaf"}, &leafError{"other"}},
+			want:  []codes.Code{codes.NotFound, codes.NotFound},
+			calls: 1,
+		},
+		{
+			name:  "wrapper",
+			errs:  []error{fmt.Errorf("a: %w", &leafError{"leaf"}), fmt.Errorf("b: %w", errors.New("other"))},
+			want:  []codes.Code{codes.Unknown, codes.Unknown},
+			calls: 2,
+		},
+		{
+			name:  "errors.New",
+			errs:  []error{errors.New("leaf"), errors.New("other")},
+			want:  []codes.Code{codes.NotFound, codes.Unknown},
+			calls: 2,
+		},
+		{
+			name:  "value kind",
+			errs:  []error{numberError(1), numberError(2)},
+			want:  []codes.Code{codes.Internal, codes.Unknown},
+			calls: 2,
+		},
+		{
+			name:  "status",
+			errs:  []error{status.Error(codes.Aborted, "a"), status.Error(codes.DataLoss, "b")},
+			want:  []codes.Code{codes.Aborted, codes.DataLoss},
+			calls: 2,
+		},
+		{
+			name:  "http code",
+			errs:  []error{&statusCodeError{http.StatusNotFound}, &statusCodeError{http.StatusConflict}},
+			want:  []codes.Code{codes.Code(http.StatusNotFound), codes.Code(http.StatusConflict)},
+			calls: 2,
+		},
+		{
+			name:  "uncached type",
+			errs:  []error{&varyingError{codes.Aborted}, &varyingError{codes.DataLoss}},
+			want:  []codes.Code{codes.Aborted, codes.DataLoss},
+			calls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &countingCoder{}
+			coder := Cached(counter, WithUncachedTypes((*varyingError)(nil)))
+			for i, err := range tt.errs {
+				if got := coder.ErrorCode(err); got != tt.want[i] {
+					t.Errorf("ErrorCode(%v): got %v; want %v", err, got, tt.want[i])
+				}
+			}
+			if counter.calls != tt.calls {
+				t.Errorf("calls: got %d; want %d", counter.calls, tt.calls)
+			}
+		})
+	}
+}
+
+// benchCachedCoder resolves leafErrors after trying the built-in coders.
+var benchCachedCoder = Compact(benchCoder, MapType[*leafError](codes.NotFound))
+
+func TestCachedAllocs(t *testing.T) {
+	coder := Cached(benchCachedCoder)
+	err := &leafError{"leaf"}
+	coder.ErrorCode(err)
+	if allocs := testing.AllocsPerRun(100, func() { coder.ErrorCode(err) }); allocs != 0 {
+		t.Errorf("ErrorCode allocated %v times; want 0", allocs)
+	}
+}
+
+func BenchmarkCached(b *testing.B) {
+	err := &leafError{"leaf"}
+	for _, bb := range []struct {
+		name  string
+		coder ErrorCoder
+	}{
+		{"uncached", benchCachedCoder},
+		{"cached", Cached(benchCachedCoder)},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if code := bb.coder.ErrorCode(err); code != codes.NotFound {
+					b.Fatalf("ErrorCode: got %v; want %v", code, codes.NotFound)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+)
+
+// reorderInterval is the number of errors between reorderings of an adaptive chain.
+const reorderInterval = 1024
+
+// A ChainBuilder builds an ErrorCoder that applies other ErrorCoders
+// in order of priority, like ErrorCoders, and returns the first code
+// that isn't Unknown. The zero value is ready to use.
+//
+// Long chains of coders, such as those in a monolith that talks to many
+// services and databases, may put the coders of the most common errors first
+// by priority or, with Adaptive, by how often they resolve errors at runtime.
+type ChainBuilder struct {
+	links    []*chainLink
+	adaptive bool
+}
+
+type chainLink struct {
+	coder    ErrorCoder
+	priority int
+	hits     atomic.Uint64
+}
+
+// Add adds the coders with the given priority. Coders with higher priorities
+// are applied first, and coders with equal priorities are applied in the order
+// in which they're added, unless the chain is adaptive.
+func (b *ChainBuilder) Add(priority int, coders ...ErrorCoder) *ChainBuilder {
+	for _, coder := range Compact(coders...) {
+		b.links = append(b.links, &chainLink{coder: coder, priority: priority})
+	}
+	return b
+}
+
+// Adaptive makes the chain periodically reorder coders with equal priorities
+// by how often they resolved errors recently, so the coders of the most common
+// errors are applied first. Coders with equal priorities must not resolve
+// different codes for the same errors, because their order may change.
+func (b *ChainBuilder) Adaptive() *ChainBuilder {
+	b.adaptive = true
+	return b
+}
+
+// Build returns the chain's ErrorCoder.
+// The builder may continue to be used to build other chains.
+func (b *ChainBuilder) Build() ErrorCoder {
+	links := make([]*chainLink, len(b.links))
+	for i, l := range b.links {
+		links[i] = &chainLink{coder: l.coder, priority: l.priority}
+	}
+	slices.SortStableFunc(links, func(a, b *chainLink) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+	if !b.adaptive {
+		coders := make(ErrorCoders, len(links))
+		for i, l := range links {
+			coders[i] = l.coder
+		}
+		return coders
+	}
+	c := &adaptiveChain{}
+	c.links.Store(&links)
+	return c
+}
+
+type adaptiveChain struct {
+	links atomic.Pointer[[]*chainLink]
+	count atomic.Uint64
+	mu    sync.Mutex // held while reordering
+}
+
+func (c *adaptiveChain) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if c.count.Add(1)%reorderInterval == 0 {
+		c.reorder()
+	}
+	for _, l := range *c.links.Load() {
+		if code := l.coder.ErrorCode(err); code != codes.Unknown {
+			l.hits.Add(1)
+			return code
+		}
+	}
+	return codes.Unknown
+}
+
+// reorder sorts the links by priority and then by hits,
+// and halves the hits so that the order adapts to recent errors.
+func (c *adaptiveChain) reorder() {
+	if !c.mu.TryLock() {
+		return
+	}
+	defer c.mu.Unlock()
+	links := slices.Clone(*c.links.Load())
+	hits := make(map[*chainLink]uint64, len(links))
+	for _, l := range links {
+		hits[l] = l.hits.Load()
+		l.hits.Store(hits[l] / 2)
+	}
+	slices.SortStableFunc(links, func(a, b *chainLink) int {
+		if c := cmp.Compare(b.priority, a.priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(hits[b], hits[a])
+	})
+	c.links.Store(&links)
+}
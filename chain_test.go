@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestChainBuilderPriority(t *testing.T) {
+	timeout := MapErrors(map[error]codes.Code{context.DeadlineExceeded: codes.Unavailable})
+	coder := new(ChainBuilder).
+		Add(0, ContextErrorCoder(), FileSystemErrorCoder()).
+		Add(1, timeout).
+		Build()
+
+	want := ErrorCoders{timeout, ContextErrorCoder(), FileSystemErrorCoder()}
+	if got, ok := coder.(ErrorCoders); !ok || !slices.Equal(got, want) {
+		t.Fatalf("Build: got %v; want %v", coder, want)
+	}
+	if got := coder.ErrorCode(context.DeadlineExceeded); got != codes.Unavailable {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.Unavailable)
+	}
+}
+
+func TestChainBuilderAdaptive(t *testing.T) {
+	coder := new(ChainBuilder).
+		Add(1, CodedErrorCoder()).
+		Add(0, ContextErrorCoder(), FileSystemErrorCoder(), TransientErrorCoder()).
+		Adaptive().
+		Build()
+	chain := coder.(*adaptiveChain)
+
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errors.New("boom"), codes.Unknown},
+		{New(codes.Aborted, fs.ErrNotExist), codes.Aborted},
+		{fmt.Errorf("open: %w", fs.ErrNotExist), codes.NotFound},
+	}
+	for range reorderInterval {
+		for _, tt := range tests {
+			if got := coder.ErrorCode(tt.err); got != tt.want {
+				t.Fatalf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+			}
+		}
+	}
+
+	var got []ErrorCoder
+	for _, l := range *chain.links.Load() {
+		got = append(got, l.coder)
+	}
+	want := []ErrorCoder{CodedErrorCoder(), FileSystemErrorCoder(), ContextErrorCoder(), TransientErrorCoder()}
+	if !slices.Equal(got, want) {
+		t.Errorf("order: got %v; want %v", got, want)
+	}
+}
+
+func BenchmarkChainBuilder(b *testing.B) {
+	coders := []ErrorCoder{
+		CodedErrorCoder(),
+		ContextErrorCoder(),
+		TransientErrorCoder(),
+		IOErrorCoder(),
+		FileSystemErrorCoder(),
+	}
+	err := fmt.Errorf("open: %w", fs.ErrNotExist)
+	for _, bb := range []struct {
+		name  string
+		coder ErrorCoder
+	}{
+		{"static", new(ChainBuilder).Add(0, coders...).Build()},
+		{"adaptive", new(ChainBuilder).Add(0, coders...).Adaptive().Build()},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if code := bb.coder.ErrorCode(err); code != codes.NotFound {
+					b.Fatalf("ErrorCode: got %v; want %v", code, codes.NotFound)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+)
+
+// A Class is a broad classification of codes that describes who is at fault
+// for an error, such as for availability SLOs.
+type Class int
+
+// Classes of codes.
+const (
+	// Success is the class of OK.
+	Success Class = iota
+	// ClientError is the class of errors caused by the client, which must
+	// change the request before retrying it.
+	ClientError
+	// ServerError is the class of errors caused by the server.
+	ServerError
+	// Transient is the class of errors caused by the server, or its
+	// dependencies, that may succeed if they're retried.
+	Transient
+)
+
+var classNames = [...]string{
+	Success:     "Success",
+	ClientError: "ClientError",
+	ServerError: "ServerError",
+	Transient:   "Transient",
+}
+
+func (c Class) String() string {
+	if 0 <= c && int(c) < len(classNames) {
+		return classNames[c]
+	}
+	return "Class(" + strconv.Itoa(int(c)) + ")"
+}
+
+// SEE: https://google.aip.dev/193
+// SEE: https://cloud.google.com/apis/design/errors#handling_errors
+
+var codeClasses = [...]Class{
+	codes.OK: Success,
+
+	codes.Canceled:           ClientError,
+	codes.InvalidArgument:    ClientError,
+	codes.NotFound:           ClientError,
+	codes.AlreadyExists:      ClientError,
+	codes.PermissionDenied:   ClientError,
+	codes.FailedPrecondition: ClientError,
+	codes.OutOfRange:         ClientError,
+	codes.Unauthenticated:    ClientError,
+
+	codes.DeadlineExceeded:  Transient,
+	codes.ResourceExhausted: Transient,
+	codes.Aborted:           Transient,
+	codes.Unavailable:       Transient,
+
+	codes.Unknown:       ServerError,
+	codes.Unimplemented: ServerError,
+	codes.Internal:      ServerError,
+	codes.DataLoss:      ServerError,
+}
+
+// ClassOf returns the class of the code. Unrecognized codes are ServerErrors.
+func ClassOf(code codes.Code) Class {
+	if int(code) < len(codeClasses) {
+		return codeClasses[code]
+	}
+	return ServerError
+}
+
+// IsServerFault reports whether the code of the error resolved by the coders
+// is a ServerError or Transient, which count against the server's availability.
+// If the error is nil, it returns false.
+func IsServerFault(err error, coders ...ErrorCoder) bool {
+	if err == nil {
+		return false
+	}
+	switch ClassOf(Compact(coders...).ErrorCode(err)) {
+	case ServerError, Transient:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestClassOf(t *testing.T) {
+	tests := []struct {
+		code  codes.Code
+		class Class
+	}{
+		{codes.OK, Success},
+		{codes.NotFound, ClientError},
+		{codes.Canceled, ClientError},
+		{codes.Unavailable, Transient},
+		{codes.DeadlineExceeded, Transient},
+		{codes.Internal, ServerError},
+		{codes.Unimplemented, ServerError},
+		{codes.Code(100), ServerError},
+	}
+	for _, tt := range tests {
+		if got := ClassOf(tt.code); got != tt.class {
+			t.Errorf("ClassOf(%v): got %v; want %v", tt.code, got, tt.class)
+		}
+	}
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if ClassOf(code) == Success && code != codes.OK {
+			t.Errorf("ClassOf(%v): unclassified", code)
+		}
+	}
+}
+
+func TestIsServerFault(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{fmt.Errorf("open: %w", fs.ErrNotExist), false},
+		{context.DeadlineExceeded, true},
+		{errors.New("boom"), true},
+	}
+	for _, tt := range tests {
+		if got := IsServerFault(tt.err, ContextErrorCoder(), FileSystemErrorCoder()); got != tt.want {
+			t.Errorf("IsServerFault(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
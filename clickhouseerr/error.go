@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package clickhouseerr provides the ability to extract the status code from ClickHouse errors
+// from the github.com/ClickHouse/clickhouse-go/v2 package.
+package clickhouseerr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the ClickHouse ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://github.com/ClickHouse/ClickHouse/blob/master/src/Common/ErrorCodes.cpp
+
+var clickhouseCodes = map[int32]codes.Code{
+	394: codes.Canceled, // QUERY_WAS_CANCELLED
+
+	6:   codes.InvalidArgument, // CANNOT_PARSE_TEXT
+	27:  codes.InvalidArgument, // CANNOT_PARSE_INPUT_ASSERTION_FAILED
+	41:  codes.InvalidArgument, // CANNOT_PARSE_DATETIME
+	42:  codes.InvalidArgument, // NUMBER_OF_ARGUMENTS_DOESNT_MATCH
+	43:  codes.InvalidArgument, // ILLEGAL_TYPE_OF_ARGUMENT
+	44:  codes.InvalidArgument, // ILLEGAL_COLUMN
+	47:  codes.InvalidArgument, // UNKNOWN_IDENTIFIER
+	53:  codes.InvalidArgument, // TYPE_MISMATCH
+	62:  codes.InvalidArgument, // SYNTAX_ERROR
+	70:  codes.InvalidArgument, // CANNOT_CONVERT_TYPE
+	115: codes.InvalidArgument, // UNKNOWN_SETTING
+
+	159: codes.DeadlineExceeded, // TIMEOUT_EXCEEDED
+	209: codes.DeadlineExceeded, // SOCKET_TIMEOUT
+
+	16:  codes.NotFound, // NO_SUCH_COLUMN_IN_TABLE
+	46:  codes.NotFound, // UNKNOWN_FUNCTION
+	60:  codes.NotFound, // UNKNOWN_TABLE
+	81:  codes.NotFound, // UNKNOWN_DATABASE
+	192: codes.NotFound, // UNKNOWN_USER
+	511: codes.NotFound, // UNKNOWN_ROLE
+
+	15:  codes.AlreadyExists, // DUPLICATE_COLUMN
+	57:  codes.AlreadyExists, // TABLE_ALREADY_EXISTS
+	82:  codes.AlreadyExists, // DATABASE_ALREADY_EXISTS
+	493: codes.AlreadyExists, // USER_ALREADY_EXISTS (ACCESS_ENTITY_ALREADY_EXISTS)
+
+	164: codes.PermissionDenied, // READONLY
+	242: codes.PermissionDenied, // TABLE_IS_READ_ONLY
+	497: codes.PermissionDenied, // ACCESS_DENIED
+
+	193: codes.Unauthenticated, // WRONG_PASSWORD
+	516: codes.Unauthenticated, // AUTHENTICATION_FAILED
+
+	201: codes.ResourceExhausted, // QUOTA_EXCEEDED (QUOTA_EXPIRED)
+	202: codes.ResourceExhausted, // TOO_MANY_SIMULTANEOUS_QUERIES
+	203: codes.ResourceExhausted, // NO_FREE_CONNECTION
+	241: codes.ResourceExhausted, // MEMORY_LIMIT_EXCEEDED
+	252: codes.ResourceExhausted, // TOO_MANY_PARTS
+	158: codes.ResourceExhausted, // TOO_MANY_ROWS
+	307: codes.ResourceExhausted, // TOO_MANY_BYTES
+	396: codes.ResourceExhausted, // TOO_MANY_ROWS_OR_BYTES
+
+	236: codes.Aborted, // ABORTED
+
+	48: codes.Unimplemented, // NOT_IMPLEMENTED
+	1:  codes.Unimplemented, // UNSUPPORTED_METHOD
+
+	210: codes.Unavailable, // NETWORK_ERROR
+	999: codes.Unavailable, // KEEPER_EXCEPTION
+
+	40: codes.DataLoss, // CHECKSUM_DOESNT_MATCH
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *clickhouse.Exception or another clickhouse error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*clickhouse.Exception](err); ok {
+		if code, ok := clickhouseCodes[e.Code]; ok {
+			return code
+		}
+		return codes.Unknown
+	}
+	switch {
+	case errcode.Is(err, clickhouse.ErrAcquireConnTimeout):
+		return codes.DeadlineExceeded
+	case errcode.Is(err, clickhouse.ErrConnectionClosed):
+		return codes.Unavailable
+	case errcode.Is(err, clickhouse.ErrBatchAlreadySent):
+		return codes.FailedPrecondition
+	case errcode.Is(err, clickhouse.ErrBatchInvalid),
+		errcode.Is(err, clickhouse.ErrBindMixedParamsFormats):
+		return codes.InvalidArgument
+	case errcode.Is(err, clickhouse.ErrUnsupportedServerRevision):
+		return codes.Unimplemented
+	}
+	return codes.Unknown
+}
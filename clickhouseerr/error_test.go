@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package clickhouseerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{&clickhouse.Exception{Code: 15, Name: "DUPLICATE_COLUMN"}, codes.AlreadyExists},
+		{&clickhouse.Exception{Code: 44, Name: "ILLEGAL_COLUMN"}, codes.InvalidArgument},
+		{fmt.Errorf("query: %w", &clickhouse.Exception{Code: 60, Name: "UNKNOWN_TABLE"}), codes.NotFound},
+		{&clickhouse.Exception{Code: 100000}, codes.Unknown},
+		{fmt.Errorf("acquire: %w", clickhouse.ErrAcquireConnTimeout), codes.DeadlineExceeded},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "duplicate column", Err: &clickhouse.Exception{Code: 15, Name: "DUPLICATE_COLUMN"}, Want: codes.AlreadyExists},
+		{Name: "unknown table", Err: &clickhouse.Exception{Code: 60, Name: "UNKNOWN_TABLE"}, Want: codes.NotFound},
+		{Name: "unknown exception", Err: &clickhouse.Exception{Code: 100000}, Want: codes.Unknown},
+		{Name: "acquire timeout", Err: clickhouse.ErrAcquireConnTimeout, Want: codes.DeadlineExceeded},
+	})
+}
@@ -0,0 +1,425 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Command gentables generates the code tables of table-driven ErrorCoders
+// from curated policies and the vendors' published error references.
+//
+// A policy is a text file that assigns gRPC codes to vendor errors, one per line.
+// Each line has the error's key followed by the name of its code and an optional
+// "#" comment. Blank lines separate groups, which are preserved in the generated
+// table, and lines that start with "#" are comments.
+//
+//	# Integrity constraint violations.
+//	ER_DUP_ENTRY AlreadyExists
+//	ER_ROW_IS_REFERENCED_2 FailedPrecondition
+//
+// The key of a MySQL error is its symbol, such as ER_DUP_ENTRY, and its number
+// and message are taken from the reference. The key of a PostgreSQL error is
+// its SQLSTATE or two character class, and its condition name is taken from
+// the reference. Every key must be found in the reference, so removed or
+// misspelled errors are reported rather than silently dropped.
+//
+// Other table-driven coders may use the "table" vendor, which has no reference.
+// The key of each error is its number, or a string if the key type is string,
+// and its comment is taken from the policy.
+//
+//	1205 Aborted # lock timeout
+//
+// By default, a map is generated. For integer keys, a lookup function with
+// a switch statement may be generated instead, which the compiler turns into
+// a binary search or a jump table that's faster than a map lookup.
+//
+// Before the output file is replaced, the lines that changed are written to
+// the standard error as a review diff, along with the number of errors in the
+// reference that aren't mapped by the policy.
+//
+// Usage:
+//
+//	gentables -vendor=mysql|postgres|table -policy=FILE -out=FILE -pkg=NAME (-var=NAME | -func=NAME) [-keytype=TYPE] [-ref=URL|FILE]
+//
+// It may be run by go generate from other modules:
+//
+//	//go:generate go run bursavich.dev/errcode/cmd/gentables -vendor=table -keytype=uint32 -policy=codes.txt -out=codes_gen.go -pkg=fooerr -func=fooCode
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// A vendor describes how to parse a vendor's reference and render its table.
+type vendor struct {
+	ref     string                                      // URL of the published reference
+	keyType string                                      // Go type of the table's keys
+	parse   func(ref []byte) (map[string]*entry, error) // parses the reference
+}
+
+var vendors = map[string]*vendor{
+	"mysql": {
+		ref:     "https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html",
+		keyType: "uint16",
+		parse:   parseMySQL,
+	},
+	"postgres": {
+		ref:     "https://www.postgresql.org/docs/current/errcodes-appendix.html",
+		keyType: "string",
+		parse:   parsePostgres,
+	},
+	"table": {
+		keyType: "int",
+	},
+}
+
+var integerTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// An entry is an error from a vendor's reference.
+type entry struct {
+	key     string // Go literal of the table's key
+	comment string // description of the error
+}
+
+// A rule is a line of a policy.
+type rule struct {
+	key     string
+	code    codes.Code
+	comment string
+}
+
+// A config describes the generated code.
+type config struct {
+	pkg     string // name of the package
+	name    string // name of the table's variable or the lookup function
+	keyType string // Go type of the keys
+	ref     string // reference of the errors, if any
+	lookup  bool   // generate a lookup function instead of a table
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gentables: ")
+
+	var (
+		vendorName = flag.String("vendor", "", "vendor of the errors: mysql, postgres, or table")
+		policyPath = flag.String("policy", "", "path of the policy")
+		refPath    = flag.String("ref", "", "URL or path of the reference (default: the vendor's published reference)")
+		outPath    = flag.String("out", "", "path of the generated file")
+		pkgName    = flag.String("pkg", "", "name of the generated file's package")
+		varName    = flag.String("var", "", "name of the generated table's variable")
+		funcName   = flag.String("func", "", "name of the generated lookup function, which requires integer keys")
+		keyType    = flag.String("keytype", "", "Go type of the keys (default: the vendor's key type)")
+	)
+	flag.Parse()
+
+	v, ok := vendors[*vendorName]
+	if !ok {
+		log.Fatalf("unknown vendor: %q", *vendorName)
+	}
+	if *policyPath == "" || *outPath == "" || *pkgName == "" || (*varName == "") == (*funcName == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	cfg := config{
+		pkg:     *pkgName,
+		name:    *varName + *funcName,
+		keyType: v.keyType,
+		ref:     *refPath,
+		lookup:  *funcName != "",
+	}
+	if *keyType != "" {
+		cfg.keyType = *keyType
+	}
+	if cfg.ref == "" {
+		cfg.ref = v.ref
+	}
+
+	policy, err := os.ReadFile(*policyPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	groups, err := parsePolicy(policy)
+	if err != nil {
+		log.Fatalf("%s: %v", *policyPath, err)
+	}
+	var entries map[string]*entry
+	if v.parse == nil {
+		if entries, err = tableEntries(groups, cfg.keyType); err != nil {
+			log.Fatalf("%s: %v", *policyPath, err)
+		}
+	} else {
+		b, err := readRef(cfg.ref)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if entries, err = v.parse(b); err != nil {
+			log.Fatalf("%s: %v", cfg.ref, err)
+		}
+	}
+	src, mapped, err := generate(entries, groups, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	old, err := os.ReadFile(*outPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Fatal(err)
+	}
+	writeDiff(os.Stderr, old, src)
+	fmt.Fprintf(os.Stderr, "%s: %d of %d reference entries are mapped\n", *outPath, mapped, len(entries))
+	if err := os.WriteFile(*outPath, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parsePolicy returns the groups of rules of a policy.
+func parsePolicy(b []byte) ([][]rule, error) {
+	var groups [][]rule
+	var group []rule
+	seen := make(map[string]bool)
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		line, comment, _ := strings.Cut(line, "#")
+		line, comment = strings.TrimSpace(line), strings.TrimSpace(comment)
+		if line == "" {
+			if len(group) > 0 {
+				groups = append(groups, group)
+				group = nil
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: want key and code: %q", n, line)
+		}
+		code, err := errcode.ParseCode(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		if seen[fields[0]] {
+			return nil, fmt.Errorf("line %d: duplicate key: %s", n, fields[0])
+		}
+		seen[fields[0]] = true
+		if code == codes.OK {
+			return nil, fmt.Errorf("line %d: errors must not be mapped to OK: %s", n, fields[0])
+		}
+		group = append(group, rule{key: fields[0], code: code, comment: comment})
+	}
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+	return groups, s.Err()
+}
+
+// readRef reads the reference from a URL or a file.
+func readRef(ref string) ([]byte, error) {
+	if !strings.HasPrefix(ref, "https://") && !strings.HasPrefix(ref, "http://") {
+		return os.ReadFile(ref)
+	}
+	resp, err := http.Get(ref)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", ref, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+var (
+	tagPattern   = regexp.MustCompile(`<[^>]*>`)
+	spacePattern = regexp.MustCompile(`\s+`)
+	mysqlPattern = regexp.MustCompile(`^(\d+); Symbol: (\w+);(?: SQLSTATE: \w+)? Message: (.*)$`)
+)
+
+// parseMySQL parses the MySQL error message reference, which describes
+// each error as "Error number: N; Symbol: S; SQLSTATE: X Message: M".
+func parseMySQL(b []byte) (map[string]*entry, error) {
+	text := tagPattern.ReplaceAllString(string(b), " ")
+	text = spacePattern.ReplaceAllString(html.UnescapeString(text), " ")
+	entries := make(map[string]*entry)
+	for _, chunk := range strings.Split(text, "Error number: ")[1:] {
+		chunk = strings.ReplaceAll(strings.TrimSpace(chunk), " ;", ";")
+		m := mysqlPattern.FindStringSubmatch(chunk)
+		if m == nil {
+			continue
+		}
+		if _, err := strconv.ParseUint(m[1], 10, 16); err != nil {
+			return nil, fmt.Errorf("%s: invalid number: %s", m[2], m[1])
+		}
+		entries[m[2]] = &entry{
+			key:     m[1],
+			comment: m[2] + "; " + strings.TrimSpace(m[3]),
+		}
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no errors found")
+	}
+	return entries, nil
+}
+
+var postgresPattern = regexp.MustCompile(`<code class="literal">([0-9A-Z]{5})</code>\s*</td>\s*<td>\s*<code class="symbol">([a-z_]+)</code>`)
+
+// parsePostgres parses the PostgreSQL error codes appendix, which lists
+// each error's SQLSTATE and condition name in a table. Each class is
+// described by the condition name of its "000" subclass.
+func parsePostgres(b []byte) (map[string]*entry, error) {
+	entries := make(map[string]*entry)
+	for _, m := range postgresPattern.FindAllSubmatch(b, -1) {
+		state, name := string(m[1]), string(m[2])
+		entries[state] = &entry{key: strconv.Quote(state), comment: name}
+		if strings.HasSuffix(state, "000") {
+			class := state[:2]
+			entries[class] = &entry{key: strconv.Quote(class), comment: name}
+		}
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no errors found")
+	}
+	return entries, nil
+}
+
+// tableEntries returns the entries of a policy that has no reference.
+func tableEntries(groups [][]rule, keyType string) (map[string]*entry, error) {
+	entries := make(map[string]*entry)
+	for _, group := range groups {
+		for _, r := range group {
+			key := strconv.Quote(r.key)
+			if keyType != "string" {
+				if _, err := strconv.ParseInt(r.key, 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid %s key: %s", keyType, r.key)
+				}
+				key = r.key
+			}
+			entries[r.key] = &entry{key: key}
+		}
+	}
+	return entries, nil
+}
+
+const header = `// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Code generated by gentables; DO NOT EDIT.
+
+`
+
+// generate returns the formatted source of the table or lookup function
+// and the number of errors it maps.
+func generate(entries map[string]*entry, groups [][]rule, cfg config) ([]byte, int, error) {
+	if cfg.lookup && !integerTypes[cfg.keyType] {
+		return nil, 0, fmt.Errorf("lookup functions require integer keys: %s", cfg.keyType)
+	}
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", cfg.pkg)
+	b.WriteString("import \"google.golang.org/grpc/codes\"\n\n")
+	if cfg.ref != "" {
+		fmt.Fprintf(&b, "// SEE: %s\n\n", cfg.ref)
+	}
+	if cfg.lookup {
+		fmt.Fprintf(&b, "// %s returns the code mapped to the key and reports whether it's mapped.\n", cfg.name)
+		fmt.Fprintf(&b, "func %s(key %s) (codes.Code, bool) {\nswitch key {\n", cfg.name, cfg.keyType)
+	} else {
+		fmt.Fprintf(&b, "var %s = map[%s]codes.Code{\n", cfg.name, cfg.keyType)
+	}
+	var missing []string
+	mapped := 0
+	for i, group := range groups {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		for j, r := range group {
+			e, ok := entries[r.key]
+			if !ok {
+				missing = append(missing, r.key)
+				continue
+			}
+			mapped++
+			comment := e.comment
+			if comment == "" {
+				comment = r.comment
+			}
+			if comment != "" {
+				comment = " // " + comment
+			}
+			if !cfg.lookup {
+				fmt.Fprintf(&b, "%s: codes.%s,%s\n", e.key, r.code, comment)
+				continue
+			}
+			// Consecutive rules with the same code share a case clause.
+			if j == 0 || group[j-1].code != r.code {
+				b.WriteString("case ")
+			}
+			if j+1 < len(group) && group[j+1].code == r.code {
+				fmt.Fprintf(&b, "%s,%s\n", e.key, comment)
+				continue
+			}
+			fmt.Fprintf(&b, "%s:%s\nreturn codes.%s, true\n", e.key, comment, r.code)
+		}
+	}
+	if cfg.lookup {
+		b.WriteString("}\nreturn codes.Unknown, false\n")
+	}
+	b.WriteString("}\n")
+	if len(missing) > 0 {
+		return nil, 0, fmt.Errorf("errors not found in reference: %s", strings.Join(missing, ", "))
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, 0, err
+	}
+	return src, mapped, nil
+}
+
+// writeDiff writes the lines that were removed from old and added to new.
+func writeDiff(w io.Writer, old, new []byte) {
+	count := make(map[string]int)
+	for _, line := range strings.Split(string(old), "\n") {
+		count[line]++
+	}
+	var added []string
+	for _, line := range strings.Split(string(new), "\n") {
+		if count[line] > 0 {
+			count[line]--
+			continue
+		}
+		added = append(added, line)
+	}
+	for _, line := range strings.Split(string(old), "\n") {
+		if count[line] > 0 {
+			count[line]--
+			fmt.Fprintf(w, "-%s\n", line)
+		}
+	}
+	for _, line := range added {
+		fmt.Fprintf(w, "+%s\n", line)
+	}
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const mysqlRef = `
+<li class="listitem"><p><a name="error_er_dup_entry"></a>
+Error number: <code class="literal">1062</code>; Symbol:
+<code class="literal">ER_DUP_ENTRY</code>; SQLSTATE:
+<code class="literal">23000</code></p><p>
+Message: Duplicate entry &#39;%s&#39; for key %d</p></li>
+<li class="listitem"><p><a name="error_er_lock_deadlock"></a>
+Error number: <code class="literal">1213</code>; Symbol:
+<code class="literal">ER_LOCK_DEADLOCK</code>; SQLSTATE:
+<code class="literal">40001</code></p><p>
+Message: Deadlock found when trying to get lock; try restarting transaction</p></li>
+`
+
+const postgresRef = `
+<tr><td colspan="2"><span class="bold"><strong>Class 23 — Integrity Constraint Violation</strong></span></td></tr>
+<tr><td><code class="literal">23000</code></td><td><code class="symbol">integrity_constraint_violation</code></td></tr>
+<tr><td><code class="literal">23505</code></td>
+<td><code class="symbol">unique_violation</code></td></tr>
+`
+
+func TestGenerateMySQL(t *testing.T) {
+	entries, err := parseMySQL([]byte(mysqlRef))
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := parsePolicy([]byte("# Comment.\nER_DUP_ENTRY AlreadyExists\n\nER_LOCK_DEADLOCK ABORTED\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, mapped, err := generate(entries, groups, config{pkg: "mysqlerr", name: "mysqlCodes", keyType: "uint16", ref: "REF"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapped != 2 {
+		t.Errorf("mapped: got %d; want 2", mapped)
+	}
+	want := `var mysqlCodes = map[uint16]codes.Code{
+	1062: codes.AlreadyExists, // ER_DUP_ENTRY; Duplicate entry '%s' for key %d
+
+	1213: codes.Aborted, // ER_LOCK_DEADLOCK; Deadlock found when trying to get lock; try restarting transaction
+}
+`
+	if !strings.HasSuffix(string(src), want) {
+		t.Errorf("got:\n%s\nwant suffix:\n%s", src, want)
+	}
+}
+
+func TestGeneratePostgres(t *testing.T) {
+	entries, err := parsePostgres([]byte(postgresRef))
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := parsePolicy([]byte("23505 AlreadyExists\n23 FailedPrecondition\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, _, err := generate(entries, groups, config{pkg: "pgerr", name: "pgCodes", keyType: "string", ref: "REF"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `var pgCodes = map[string]codes.Code{
+	"23505": codes.AlreadyExists,      // unique_violation
+	"23":    codes.FailedPrecondition, // integrity_constraint_violation
+}
+`
+	if !strings.HasSuffix(string(src), want) {
+		t.Errorf("got:\n%s\nwant suffix:\n%s", src, want)
+	}
+}
+
+func TestGenerateLookup(t *testing.T) {
+	groups, err := parsePolicy([]byte("1205 Aborted # lock timeout\n1213 Aborted\n1062 AlreadyExists\n\n2006 Unavailable # gone away\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := tableEntries(groups, "uint16")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, mapped, err := generate(entries, groups, config{pkg: "fooerr", name: "fooCode", keyType: "uint16", lookup: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapped != 4 {
+		t.Errorf("mapped: got %d; want 4", mapped)
+	}
+	want := `import "google.golang.org/grpc/codes"
+
+// fooCode returns the code mapped to the key and reports whether it's mapped.
+func fooCode(key uint16) (codes.Code, bool) {
+	switch key {
+	case 1205, // lock timeout
+		1213:
+		return codes.Aborted, true
+	case 1062:
+		return codes.AlreadyExists, true
+
+	case 2006: // gone away
+		return codes.Unavailable, true
+	}
+	return codes.Unknown, false
+}
+`
+	if !strings.HasSuffix(string(src), want) {
+		t.Errorf("got:\n%s\nwant suffix:\n%s", src, want)
+	}
+}
+
+func TestGenerateLookupErrors(t *testing.T) {
+	groups, err := parsePolicy([]byte("ER_DUP_ENTRY AlreadyExists\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tableEntries(groups, "uint16"); err == nil {
+		t.Error("expected an error for a key that isn't a number")
+	}
+	entries := map[string]*entry{"ER_DUP_ENTRY": {key: `"ER_DUP_ENTRY"`}}
+	if _, _, err := generate(entries, groups, config{pkg: "fooerr", name: "fooCode", keyType: "string", lookup: true}); err == nil {
+		t.Error("expected an error for a lookup function with string keys")
+	}
+}
+
+func TestGenerateMissing(t *testing.T) {
+	entries, err := parsePostgres([]byte(postgresRef))
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := parsePolicy([]byte("23506 AlreadyExists\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := generate(entries, groups, config{pkg: "pgerr", name: "pgCodes", keyType: "string", ref: "REF"}); err == nil {
+		t.Error("expected an error for a key that isn't in the reference")
+	}
+}
+
+func TestParsePolicyErrors(t *testing.T) {
+	for _, policy := range []string{
+		"ER_DUP_ENTRY\n",
+		"ER_DUP_ENTRY NotACode\n",
+		"ER_DUP_ENTRY NotFound\nER_DUP_ENTRY AlreadyExists\n",
+		"ER_DUP_ENTRY OK\n",
+	} {
+		if _, err := parsePolicy([]byte(policy)); err == nil {
+			t.Errorf("parsePolicy(%q): expected an error", policy)
+		}
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	var b bytes.Buffer
+	writeDiff(&b, []byte("a\nb\nc\n"), []byte("a\nc\nd\n"))
+	if got, want := b.String(), "-b\n+d\n"; got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// The canonical names of the codes, as used by google.rpc.Code.
+var codeNames = [...]string{
+	codes.OK:                 "OK",
+	codes.Canceled:           "CANCELLED",
+	codes.Unknown:            "UNKNOWN",
+	codes.InvalidArgument:    "INVALID_ARGUMENT",
+	codes.DeadlineExceeded:   "DEADLINE_EXCEEDED",
+	codes.NotFound:           "NOT_FOUND",
+	codes.AlreadyExists:      "ALREADY_EXISTS",
+	codes.PermissionDenied:   "PERMISSION_DENIED",
+	codes.ResourceExhausted:  "RESOURCE_EXHAUSTED",
+	codes.FailedPrecondition: "FAILED_PRECONDITION",
+	codes.Aborted:            "ABORTED",
+	codes.OutOfRange:         "OUT_OF_RANGE",
+	codes.Unimplemented:      "UNIMPLEMENTED",
+	codes.Internal:           "INTERNAL",
+	codes.Unavailable:        "UNAVAILABLE",
+	codes.DataLoss:           "DATA_LOSS",
+	codes.Unauthenticated:    "UNAUTHENTICATED",
+}
+
+// Normalized names and aliases of the codes.
+var namedCodes = func() map[string]codes.Code {
+	m := make(map[string]codes.Code, len(codeNames)+1)
+	for code, name := range codeNames {
+		m[normalizeCodeName(name)] = codes.Code(code)
+	}
+	m["canceled"] = codes.Canceled // codes.Canceled.String()
+	return m
+}()
+
+func normalizeCodeName(s string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(s))
+}
+
+// CodeString returns the canonical name of the code, such as "NOT_FOUND".
+// A code without a name is formatted as its decimal value.
+func CodeString(code codes.Code) string {
+	if int(code) < len(codeNames) {
+		return codeNames[code]
+	}
+	return strconv.FormatUint(uint64(code), 10)
+}
+
+// ParseCode parses a code from its name or decimal value.
+//
+// Names are matched without regard to case, underscores, or hyphens,
+// so "NOT_FOUND", "not_found", and "NotFound" are all equivalent.
+// Both "CANCELLED" and "CANCELED" are accepted.
+func ParseCode(s string) (codes.Code, error) {
+	s = strings.TrimSpace(s)
+	if code, ok := namedCodes[normalizeCodeName(s)]; ok {
+		return code, nil
+	}
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil && n < uint64(len(codeNames)) {
+		return codes.Code(n), nil
+	}
+	return codes.Unknown, fmt.Errorf("errcode: invalid code: %q", s)
+}
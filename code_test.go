@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestParseCode(t *testing.T) {
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		for _, s := range []string{CodeString(code), code.String(), strconv.Itoa(int(code))} {
+			if got, err := ParseCode(s); err != nil || got != code {
+				t.Errorf("ParseCode(%q): got (%v, %v); want (%v, nil)", s, got, err, code)
+			}
+		}
+	}
+	for _, s := range []string{"not_found", "not-found", " NotFound "} {
+		if got, err := ParseCode(s); err != nil || got != codes.NotFound {
+			t.Errorf("ParseCode(%q): got (%v, %v); want (%v, nil)", s, got, err, codes.NotFound)
+		}
+	}
+	for _, s := range []string{"", "17", "-1", "NOPE"} {
+		if _, err := ParseCode(s); err == nil {
+			t.Errorf("ParseCode(%q): expected error", s)
+		}
+	}
+}
+
+func TestCodeString(t *testing.T) {
+	if got, want := CodeString(codes.Canceled), "CANCELLED"; got != want {
+		t.Errorf("CodeString(%d): got %q; want %q", codes.Canceled, got, want)
+	}
+	if got, want := CodeString(42), "42"; got != want {
+		t.Errorf("CodeString(42): got %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,376 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package configcoder provides the ability to build an ErrorCoder from configuration,
+// so that mappings can be tuned by operators without rebuilding a program.
+//
+// A Config is usually decoded from JSON with Parse or Coder.Load.
+// Its fields are also tagged for YAML decoders that honor yaml tags.
+package configcoder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"bursavich.dev/errcode/sqlstate"
+	"google.golang.org/grpc/codes"
+)
+
+// Config describes an ErrorCoder chain.
+//
+// The rules are grouped by kind and the groups are checked in the order
+// of the fields: sentinels, types, messages, HTTP ranges, and SQLSTATE prefixes.
+// Within a group, the rules are checked in order and the first match is used.
+type Config struct {
+	Sentinels []SentinelRule `json:"sentinels,omitempty" yaml:"sentinels,omitempty"`
+	Types     []TypeRule     `json:"types,omitempty" yaml:"types,omitempty"`
+	Messages  []MessageRule  `json:"messages,omitempty" yaml:"messages,omitempty"`
+	HTTP      []HTTPRule     `json:"http,omitempty" yaml:"http,omitempty"`
+	SQLState  []SQLStateRule `json:"sqlstate,omitempty" yaml:"sqlstate,omitempty"`
+}
+
+// A Code is a code that is encoded by its canonical name and
+// decoded from any representation accepted by errcode.ParseCode.
+type Code codes.Code
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Code) MarshalText() ([]byte, error) {
+	return []byte(errcode.CodeString(codes.Code(c))), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Code) UnmarshalText(text []byte) error {
+	code, err := errcode.ParseCode(string(text))
+	if err != nil {
+		return err
+	}
+	*c = Code(code)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts both strings and numbers.
+func (c *Code) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("configcoder: invalid code: %s", data)
+		}
+		s = n.String()
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// A SentinelRule maps errors that match a registered sentinel error to a code.
+type SentinelRule struct {
+	// Name is the name of a sentinel error in the Registry.
+	Name string `json:"name" yaml:"name"`
+	Code Code   `json:"code" yaml:"code"`
+}
+
+// A TypeRule maps errors that contain a registered type to a code.
+type TypeRule struct {
+	// Name is the name of a type in the Registry.
+	Name string `json:"name" yaml:"name"`
+	Code Code   `json:"code" yaml:"code"`
+}
+
+// A MessageRule maps errors with matching messages to a code.
+// If both Contains and Pattern are set, a message must satisfy both.
+type MessageRule struct {
+	// Contains is a substring that the message must contain.
+	Contains string `json:"contains,omitempty" yaml:"contains,omitempty"`
+	// Pattern is a regular expression that the message must match.
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Code    Code   `json:"code" yaml:"code"`
+}
+
+// An HTTPRule maps errors with an HTTP status code in the inclusive range [Min, Max] to a code.
+// If Max is zero, it is equal to Min.
+type HTTPRule struct {
+	Min  int  `json:"min" yaml:"min"`
+	Max  int  `json:"max,omitempty" yaml:"max,omitempty"`
+	Code Code `json:"code" yaml:"code"`
+}
+
+// An SQLStateRule maps errors with a SQLSTATE that has the given prefix to a code.
+// Errors report their SQLSTATE by implementing the sqlstate.Error interface.
+type SQLStateRule struct {
+	Prefix string `json:"prefix" yaml:"prefix"`
+	Code   Code   `json:"code" yaml:"code"`
+}
+
+// A Registry resolves the names of sentinel errors and types used by a Config.
+// It must not be modified concurrently with its use.
+type Registry struct {
+	errs  map[string]error
+	types map[string]func(error) bool
+}
+
+// NewRegistry returns a new Registry that includes the following standard sentinel errors:
+//
+//	context.Canceled
+//	context.DeadlineExceeded
+//	fs.ErrExist
+//	fs.ErrInvalid
+//	fs.ErrNotExist
+//	fs.ErrPermission
+//	io.EOF
+//	io.ErrUnexpectedEOF
+//	os.ErrDeadlineExceeded
+func NewRegistry() *Registry {
+	r := &Registry{
+		errs:  make(map[string]error),
+		types: make(map[string]func(error) bool),
+	}
+	r.RegisterError("context.Canceled", context.Canceled)
+	r.RegisterError("context.DeadlineExceeded", context.DeadlineExceeded)
+	r.RegisterError("fs.ErrExist", fs.ErrExist)
+	r.RegisterError("fs.ErrInvalid", fs.ErrInvalid)
+	r.RegisterError("fs.ErrNotExist", fs.ErrNotExist)
+	r.RegisterError("fs.ErrPermission", fs.ErrPermission)
+	r.RegisterError("io.EOF", io.EOF)
+	r.RegisterError("io.ErrUnexpectedEOF", io.ErrUnexpectedEOF)
+	r.RegisterError("os.ErrDeadlineExceeded", os.ErrDeadlineExceeded)
+	return r
+}
+
+// RegisterError registers a sentinel error with the given name.
+// It returns an error if the sentinel error is nil or isn't comparable.
+func (r *Registry) RegisterError(name string, err error) error {
+	if err == nil || !reflect.ValueOf(err).Comparable() {
+		return fmt.Errorf("configcoder: sentinel error %q isn't comparable", name)
+	}
+	r.errs[name] = err
+	return nil
+}
+
+// RegisterType registers type T with the given name.
+// An error matches the type if errcode.As would find a T in its tree.
+func RegisterType[T error](r *Registry, name string) {
+	r.types[name] = func(err error) bool {
+		_, ok := errcode.As[T](err)
+		return ok
+	}
+}
+
+// Parse returns an ErrorCoder built from the JSON encoded Config.
+func Parse(data []byte, r *Registry) (errcode.ErrorCoder, error) {
+	var cfg Config
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("configcoder: invalid config: %w", err)
+	}
+	return cfg.ErrorCoder(r)
+}
+
+// ErrorCoder returns an ErrorCoder built from the Config.
+// It returns an error if the Config refers to names that aren't in the Registry
+// or contains invalid rules.
+func (c *Config) ErrorCoder(r *Registry) (errcode.ErrorCoder, error) {
+	var coders errcode.ErrorCoders
+	if len(c.Sentinels) > 0 {
+		sc := make(sentinelErrorCoder, 0, len(c.Sentinels))
+		for _, rule := range c.Sentinels {
+			err, ok := r.errs[rule.Name]
+			if !ok {
+				return nil, fmt.Errorf("configcoder: unknown sentinel error: %q", rule.Name)
+			}
+			sc = append(sc, sentinelMatcher{err, codes.Code(rule.Code)})
+		}
+		coders = append(coders, sc)
+	}
+	if len(c.Types) > 0 {
+		tc := make(typeErrorCoder, 0, len(c.Types))
+		for _, rule := range c.Types {
+			fn, ok := r.types[rule.Name]
+			if !ok {
+				return nil, fmt.Errorf("configcoder: unknown type: %q", rule.Name)
+			}
+			tc = append(tc, typeMatcher{fn, codes.Code(rule.Code)})
+		}
+		coders = append(coders, tc)
+	}
+	if len(c.Messages) > 0 {
+		rules := make([]errcode.MessageRule, 0, len(c.Messages))
+		for _, rule := range c.Messages {
+			mr := errcode.MessageRule{Contains: rule.Contains, Code: codes.Code(rule.Code)}
+			if rule.Pattern != "" {
+				re, err := regexp.Compile(rule.Pattern)
+				if err != nil {
+					return nil, fmt.Errorf("configcoder: invalid message pattern: %w", err)
+				}
+				mr.Regexp = re
+			}
+			if mr.Contains == "" && mr.Regexp == nil {
+				return nil, errors.New("configcoder: message rule must have contains or pattern")
+			}
+			rules = append(rules, mr)
+		}
+		coders = append(coders, errcode.MatchMessage(rules))
+	}
+	if len(c.HTTP) > 0 {
+		hc := make(httpErrorCoder, 0, len(c.HTTP))
+		for _, rule := range c.HTTP {
+			if rule.Max == 0 {
+				rule.Max = rule.Min
+			}
+			if rule.Min < 100 || rule.Max > 599 || rule.Min > rule.Max {
+				return nil, fmt.Errorf("configcoder: invalid HTTP range: [%d, %d]", rule.Min, rule.Max)
+			}
+			hc = append(hc, rule)
+		}
+		coders = append(coders, hc)
+	}
+	if len(c.SQLState) > 0 {
+		sc := make(sqlStateErrorCoder, 0, len(c.SQLState))
+		for _, rule := range c.SQLState {
+			if len(rule.Prefix) == 0 || len(rule.Prefix) > 5 {
+				return nil, fmt.Errorf("configcoder: invalid SQLSTATE prefix: %q", rule.Prefix)
+			}
+			rule.Prefix = strings.ToUpper(rule.Prefix)
+			sc = append(sc, rule)
+		}
+		coders = append(coders, sc)
+	}
+	return coders, nil
+}
+
+type sentinelMatcher struct {
+	err  error
+	code codes.Code
+}
+
+type sentinelErrorCoder []sentinelMatcher
+
+func (c sentinelErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	for _, m := range c {
+		if errcode.Is(err, m.err) {
+			return m.code
+		}
+	}
+	return codes.Unknown
+}
+
+type typeMatcher struct {
+	match func(error) bool
+	code  codes.Code
+}
+
+type typeErrorCoder []typeMatcher
+
+func (c typeErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	for _, m := range c {
+		if m.match(err) {
+			return m.code
+		}
+	}
+	return codes.Unknown
+}
+
+type httpErrorCoder []HTTPRule
+
+func (c httpErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	e, ok := errcode.As[httperr.Error](err)
+	if !ok {
+		return codes.Unknown
+	}
+	status := e.HTTPCode()
+	for _, rule := range c {
+		if rule.Min <= status && status <= rule.Max {
+			return codes.Code(rule.Code)
+		}
+	}
+	return codes.Unknown
+}
+
+type sqlStateErrorCoder []SQLStateRule
+
+func (c sqlStateErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	e, ok := errcode.As[sqlstate.Error](err)
+	if !ok {
+		return codes.Unknown
+	}
+	state := strings.ToUpper(e.SQLState())
+	for _, rule := range c {
+		if strings.HasPrefix(state, rule.Prefix) {
+			return codes.Code(rule.Code)
+		}
+	}
+	return codes.Unknown
+}
+
+// A Coder is an ErrorCoder whose configuration can be replaced at runtime.
+// It is safe for concurrent use.
+type Coder struct {
+	reg   *Registry
+	coder atomic.Pointer[errcode.ErrorCoder]
+}
+
+// New returns a new Coder that uses the given Registry.
+// Until a configuration is loaded, it returns Unknown for all non-nil errors.
+func New(r *Registry) *Coder {
+	return &Coder{reg: r}
+}
+
+// Load replaces the Coder's configuration with the JSON encoded Config.
+// If the Config is invalid, the previous configuration remains in use.
+func (c *Coder) Load(data []byte) error {
+	coder, err := Parse(data, c.reg)
+	if err != nil {
+		return err
+	}
+	c.coder.Store(&coder)
+	return nil
+}
+
+// Update replaces the Coder's configuration with the given Config.
+// If the Config is invalid, the previous configuration remains in use.
+func (c *Coder) Update(cfg *Config) error {
+	coder, err := cfg.ErrorCoder(c.reg)
+	if err != nil {
+		return err
+	}
+	c.coder.Store(&coder)
+	return nil
+}
+
+// ErrorCode returns the code of the error according to the current configuration.
+func (c *Coder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if p := c.coder.Load(); p != nil {
+		return (*p).ErrorCode(err)
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package configcoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"bursavich.dev/errcode/httperr"
+	"google.golang.org/grpc/codes"
+)
+
+type sqlError struct{ state string }
+
+func (e *sqlError) Error() string    { return "sql error " + e.state }
+func (e *sqlError) SQLState() string { return e.state }
+
+var errConflict = errors.New("conflict")
+
+const testConfig = `{
+	"sentinels": [
+		{"name": "context.Canceled", "code": "CANCELLED"},
+		{"name": "app.ErrConflict", "code": "ABORTED"}
+	],
+	"types": [
+		{"name": "fs.PathError", "code": 5}
+	],
+	"messages": [
+		{"contains": "too many", "code": "RESOURCE_EXHAUSTED"}
+	],
+	"http": [
+		{"min": 400, "max": 499, "code": "invalid_argument"}
+	],
+	"sqlstate": [
+		{"prefix": "23", "code": "FailedPrecondition"}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.RegisterError("app.ErrConflict", errConflict); err != nil {
+		t.Fatal(err)
+	}
+	RegisterType[*fs.PathError](reg, "fs.PathError")
+
+	coder, err := Parse([]byte(testConfig), reg)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{fmt.Errorf("wrapped: %w", context.Canceled), codes.Canceled},
+		{errConflict, codes.Aborted},
+		{&fs.PathError{Op: "open", Path: "x", Err: errors.New("oops")}, codes.NotFound},
+		{errors.New("too many cooks"), codes.ResourceExhausted},
+		{httperr.New(http.StatusTeapot, errors.New("teapot")), codes.InvalidArgument},
+		{&sqlError{"23505"}, codes.FailedPrecondition},
+		{&sqlError{"42000"}, codes.Unknown},
+		{errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := coder.ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+	errcodetest.TestErrorCoder(t, coder, []errcodetest.Case{
+		{Name: "sentinel", Err: errConflict, Want: codes.Aborted},
+		{Name: "type", Err: &fs.PathError{Op: "open", Path: "x", Err: errors.New("oops")}, Want: codes.NotFound},
+		{Name: "http", Err: httperr.New(http.StatusTeapot, errors.New("teapot")), Want: codes.InvalidArgument},
+		{Name: "sqlstate", Err: &sqlError{"23505"}, Want: codes.FailedPrecondition},
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, cfg := range []string{
+		`{"sentinels": [{"name": "missing", "code": "ABORTED"}]}`,
+		`{"types": [{"name": "missing", "code": "ABORTED"}]}`,
+		`{"messages": [{"pattern": "(", "code": "ABORTED"}]}`,
+		`{"messages": [{"code": "ABORTED"}]}`,
+		`{"http": [{"min": 500, "max": 400, "code": "ABORTED"}]}`,
+		`{"sqlstate": [{"prefix": "", "code": "ABORTED"}]}`,
+		`{"sentinels": [{"name": "context.Canceled", "code": "NOPE"}]}`,
+		`{"unknown": []}`,
+	} {
+		if _, err := Parse([]byte(cfg), NewRegistry()); err == nil {
+			t.Errorf("Parse(%s): expected error", cfg)
+		}
+	}
+}
+
+func TestCoderLoad(t *testing.T) {
+	c := New(NewRegistry())
+	if got := c.ErrorCode(context.Canceled); got != codes.Unknown {
+		t.Fatalf("ErrorCode before Load: got %v; want %v", got, codes.Unknown)
+	}
+	if err := c.Load([]byte(`{"sentinels": [{"name": "context.Canceled", "code": "CANCELLED"}]}`)); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if err := c.Load([]byte(`{"sentinels": [{"name": "missing", "code": "ABORTED"}]}`)); err == nil {
+		t.Fatal("Load: expected error")
+	}
+	if got := c.ErrorCode(context.Canceled); got != codes.Canceled {
+		t.Fatalf("ErrorCode after Load: got %v; want %v", got, codes.Canceled)
+	}
+}
+
+type sliceError []string
+
+func (e sliceError) Error() string { return "slice error" }
+
+func TestRegisterErrorComparable(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.RegisterError("nil", nil); err == nil {
+		t.Error("RegisterError(nil): expected error")
+	}
+	if err := reg.RegisterError("slice", sliceError{"x"}); err == nil {
+		t.Error("RegisterError(non-comparable): expected error")
+	}
+	if err := reg.RegisterError("wrapped slice", fmt.Errorf("x: %w", sliceError{"x"})); err != nil {
+		t.Errorf("RegisterError(wrapped): %v", err)
+	}
+}
+
+func TestSentinelRuleOrder(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.RegisterError("app.ErrConflict", errConflict); err != nil {
+		t.Fatal(err)
+	}
+	coder, err := Parse([]byte(`{"sentinels": [
+		{"name": "context.Canceled", "code": "CANCELLED"},
+		{"name": "app.ErrConflict", "code": "ABORTED"},
+		{"name": "context.Canceled", "code": "UNAVAILABLE"}
+	]}`), reg)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	// The conflict appears first in the tree, but the rule for
+	// context.Canceled comes first.
+	err = errors.Join(errConflict, context.Canceled)
+	if got := coder.ErrorCode(err); got != codes.Canceled {
+		t.Errorf("ErrorCode(%v): got %v; want %v", err, got, codes.Canceled)
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package connecterr provides the ability to extract the status code from Connect errors
+// from the connectrpc.com/connect package.
+package connecterr
+
+import (
+	"context"
+
+	"bursavich.dev/errcode"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Connect ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *connect.Error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*connect.Error](err); ok {
+		return ToGRPC(e.Code())
+	}
+	return codes.Unknown
+}
+
+// ToGRPC returns the gRPC code associated with the given Connect code.
+// Connect codes share their names and values with gRPC codes.
+// It returns codes.Unknown if the Connect code isn't recognized.
+func ToGRPC(code connect.Code) codes.Code {
+	if code < connect.CodeCanceled || code > connect.CodeUnauthenticated {
+		return codes.Unknown
+	}
+	return codes.Code(code)
+}
+
+// FromGRPC returns the Connect code associated with the given gRPC code.
+// Connect doesn't have a code for OK, so it returns connect.CodeUnknown
+// for OK and for any other code that isn't recognized.
+func FromGRPC(code codes.Code) connect.Code {
+	if code < codes.Canceled || code > codes.Unauthenticated {
+		return connect.CodeUnknown
+	}
+	return connect.Code(code)
+}
+
+type interceptor struct {
+	coder errcode.ErrorCoder
+}
+
+// Interceptor returns a connect.Interceptor that converts errors returned
+// by handlers into *connect.Error values with the code resolved by the given
+// ErrorCoder. Errors that already contain a *connect.Error are returned unchanged.
+// The message of a *connect.Error is the error's public message, if it has one.
+// Client calls are not modified.
+//
+// The ErrorCoder is installed in the handler's context and may be retrieved
+// with errcode.FromContext.
+func Interceptor(coder errcode.ErrorCoder) connect.Interceptor {
+	return &interceptor{coder: coder}
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		resp, err := next(errcode.NewContext(ctx, i.coder), req)
+		return resp, i.convert(err)
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return i.convert(next(errcode.NewContext(ctx, i.coder), conn))
+	}
+}
+
+func (i *interceptor) convert(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := errcode.As[*connect.Error](err); ok {
+		return err
+	}
+	code := FromGRPC(errcode.ResolveError(i.coder, err))
+	if msg, ok := errcode.PublicMessage(err); ok {
+		return connect.NewError(code, &publicError{msg: msg, err: err})
+	}
+	return connect.NewError(code, err)
+}
+
+// A publicError replaces the message of an error with its public message,
+// which connect sends to clients, and wraps the error to preserve its chain.
+type publicError struct {
+	msg string
+	err error
+}
+
+func (e *publicError) Error() string { return e.msg }
+func (e *publicError) Unwrap() error { return e.err }
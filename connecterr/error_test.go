@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package connecterr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcodetest"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{connect.NewError(connect.CodeNotFound, errors.New("missing")), codes.NotFound},
+		{fmt.Errorf("call: %w", connect.NewError(connect.CodeUnavailable, errors.New("down"))), codes.Unavailable},
+		{connect.NewError(connect.Code(99), errors.New("invalid")), codes.Unknown},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: connect.NewError(connect.CodeNotFound, errors.New("missing")), Want: codes.NotFound},
+		{Name: "unavailable", Err: connect.NewError(connect.CodeUnavailable, errors.New("down")), Want: codes.Unavailable},
+		{Name: "invalid code", Err: connect.NewError(connect.Code(99), errors.New("invalid")), Want: codes.Unknown},
+	})
+}
+
+func TestCodes(t *testing.T) {
+	for code := codes.Canceled; code <= codes.Unauthenticated; code++ {
+		if got := ToGRPC(FromGRPC(code)); got != code {
+			t.Errorf("ToGRPC(FromGRPC(%v)): got %v", code, got)
+		}
+	}
+	if got := FromGRPC(codes.OK); got != connect.CodeUnknown {
+		t.Errorf("FromGRPC(OK): got %v; want %v", got, connect.CodeUnknown)
+	}
+	if got := ToGRPC(connect.Code(0)); got != codes.Unknown {
+		t.Errorf("ToGRPC(0): got %v; want %v", got, codes.Unknown)
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	coder := errcode.FileSystemErrorCoder()
+	tests := []struct {
+		name string
+		err  error
+		code connect.Code
+		msg  string
+	}{
+		{name: "nil"},
+		{
+			name: "coded",
+			err:  fmt.Errorf("open: %w", fs.ErrNotExist),
+			code: connect.CodeNotFound,
+			msg:  "open: file does not exist",
+		},
+		{
+			name: "connect",
+			err:  fmt.Errorf("update: %w", connect.NewError(connect.CodeAborted, errors.New("conflict"))),
+			code: connect.CodeAborted,
+			msg:  "conflict",
+		},
+		{
+			name: "public",
+			err:  errcode.WithPublicMessage(fmt.Errorf("open /etc/secret: %w", fs.ErrPermission), "access denied"),
+			code: connect.CodePermissionDenied,
+			msg:  "access denied",
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			code: connect.CodeUnknown,
+			msg:  "boom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := Interceptor(coder)
+			unary := i.WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				if got, ok := errcode.FromContext(ctx); !ok || got != coder {
+					t.Errorf("FromContext: got %v, %v; want %v, true", got, ok, coder)
+				}
+				return nil, tt.err
+			})
+			stream := i.WrapStreamingHandler(func(context.Context, connect.StreamingHandlerConn) error {
+				return tt.err
+			})
+			_, unaryErr := unary(context.Background(), connect.NewRequest(&struct{}{}))
+			streamErr := stream(context.Background(), nil)
+			for _, err := range []error{unaryErr, streamErr} {
+				if tt.err == nil {
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					continue
+				}
+				var e *connect.Error
+				if !errors.As(err, &e) {
+					t.Fatalf("error is not a connect error: %v", err)
+				}
+				if e.Code() != tt.code {
+					t.Errorf("code: got %v; want %v", e.Code(), tt.code)
+				}
+				if e.Message() != tt.msg {
+					t.Errorf("message: got %q; want %q", e.Message(), tt.msg)
+				}
+				if !errors.Is(err, tt.err) {
+					t.Errorf("error chain doesn't contain the cause: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import "context"
+
+type coderContextKey struct{}
+
+// NewContext returns a copy of the parent context that carries the given ErrorCoder.
+//
+// It allows middleware to install a request-scoped ErrorCoder that may be
+// retrieved by FromContext without passing it through every call.
+func NewContext(parent context.Context, coder ErrorCoder) context.Context {
+	return context.WithValue(parent, coderContextKey{}, coder)
+}
+
+// FromContext returns the ErrorCoder carried by the context, if any.
+func FromContext(ctx context.Context) (ErrorCoder, bool) {
+	coder, ok := ctx.Value(coderContextKey{}).(ErrorCoder)
+	return coder, ok
+}
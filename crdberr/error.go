@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package crdberr provides the ability to extract the status code from CockroachDB
+// transaction errors, including those from the github.com/cockroachdb/cockroach-go/v2/crdb package.
+//
+// It should be used before a general PostgreSQL ErrorCoder, such as pgerr,
+// which isn't aware of CockroachDB's retry semantics.
+package crdberr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/sqlstate"
+	"github.com/cockroachdb/cockroach-go/v2/crdb"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the CockroachDB ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://www.cockroachlabs.com/docs/stable/transaction-retry-error-reference
+
+const (
+	serializationFailure       = "40001" // A transaction retry error.
+	retriableError             = "CR000" // A legacy CockroachDB transaction retry error.
+	statementCompletionUnknown = "40003" // An ambiguous result error.
+)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it is a CockroachDB transaction retry or ambiguous result error.
+//
+// Retry errors are mapped to Aborted and ambiguous results,
+// which may or may not have been committed, are mapped to Unavailable.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if _, ok := errcode.As[*crdb.AmbiguousCommitError](err); ok {
+		return codes.Unavailable
+	}
+	if _, ok := errcode.As[*crdb.MaxRetriesExceededError](err); ok {
+		return codes.Aborted
+	}
+	if _, ok := errcode.As[*crdb.TxnRestartError](err); ok {
+		return codes.Aborted
+	}
+	switch sqlState(err) {
+	case serializationFailure, retriableError:
+		return codes.Aborted
+	case statementCompletionUnknown:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// Retryable reports whether the error is a transaction retry error.
+//
+// It agrees with the retry logic of crdb.ExecuteTx, so it may be used
+// to retry transactions that are managed without it.
+func Retryable(err error) bool {
+	switch sqlState(err) {
+	case serializationFailure, retriableError:
+		return true
+	}
+	return false
+}
+
+// sqlState returns the SQLSTATE of the first error in the tree that has one.
+// Like the crdb package, it follows both Unwrap and Cause methods.
+func sqlState(err error) string {
+	if err == nil {
+		return ""
+	}
+	var state string
+	errcode.Walk(causeTree{err}, func(err error) bool {
+		if e, ok := err.(causeTree).error.(sqlstate.Error); ok {
+			state = e.SQLState()
+			return false
+		}
+		return true
+	})
+	return state
+}
+
+// causeTree adapts the Cause methods of github.com/pkg/errors to Unwrap,
+// so that errcode.Walk follows them within its limits.
+type causeTree struct{ error }
+
+func (t causeTree) Unwrap() []error {
+	switch x := t.error.(type) {
+	case interface{ Unwrap() error }:
+		return causeTrees(x.Unwrap())
+	case interface{ Unwrap() []error }:
+		return causeTrees(x.Unwrap()...)
+	case interface{ Cause() error }:
+		return causeTrees(x.Cause())
+	}
+	return nil
+}
+
+func causeTrees(errs ...error) []error {
+	list := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			list = append(list, causeTree{err})
+		}
+	}
+	return list
+}
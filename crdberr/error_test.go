@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package crdberr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+type stateError string
+
+func (e stateError) Error() string    { return "pq: " + string(e) }
+func (e stateError) SQLState() string { return string(e) }
+
+// causeError follows the github.com/pkg/errors convention.
+type causeError struct{ cause error }
+
+func (e *causeError) Error() string { return "cause: " + e.cause.Error() }
+func (e *causeError) Cause() error  { return e.cause }
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "serialization failure", Err: stateError(serializationFailure), Want: codes.Aborted},
+		{Name: "retriable", Err: stateError(retriableError), Want: codes.Aborted},
+		{Name: "ambiguous result", Err: stateError(statementCompletionUnknown), Want: codes.Unavailable},
+		{Name: "other state", Err: stateError("23505"), Want: codes.Unknown},
+		{Name: "cause", Err: &causeError{stateError(serializationFailure)}, Want: codes.Aborted},
+	})
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{stateError(serializationFailure), true},
+		{fmt.Errorf("commit: %w", stateError(retriableError)), true},
+		{&causeError{stateError(serializationFailure)}, true},
+		{errors.Join(errors.New("boom"), &causeError{stateError(retriableError)}), true},
+		{stateError(statementCompletionUnknown), false},
+		{errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := Retryable(tt.err); got != tt.want {
+			t.Errorf("Retryable(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
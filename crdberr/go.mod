@@ -0,0 +1,18 @@
+module bursavich.dev/errcode/crdberr
+
+go 1.24.0
+
+require (
+	bursavich.dev/errcode v0.2.0
+	github.com/cockroachdb/cockroach-go/v2 v2.2.0
+	google.golang.org/grpc v1.72.2
+)
+
+require (
+	github.com/lib/pq v1.10.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
+)
+
+replace bursavich.dev/errcode => ../
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package decodeerr provides the ability to extract the status code from decoding errors,
+// such as those from the encoding/json and google.golang.org/protobuf packages.
+//
+// Malformed input is a client error, so decoding failures are mapped to InvalidArgument.
+// Failures that indicate a programming error, such as decoding into a non-pointer,
+// are mapped to Internal.
+package decodeerr
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the decoding ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a decoding error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	switch {
+	case as[*json.SyntaxError](err),
+		as[*json.UnmarshalTypeError](err),
+		as[*xml.SyntaxError](err),
+		as[*xml.UnmarshalError](err),
+		as[base64.CorruptInputError](err),
+		as[hex.InvalidByteError](err),
+		as[*strconv.NumError](err),
+		errcode.Is(err, hex.ErrLength),
+		errcode.Is(err, proto.Error):
+		return codes.InvalidArgument
+	case as[*json.InvalidUnmarshalError](err),
+		as[*json.UnsupportedTypeError](err),
+		as[*json.UnsupportedValueError](err),
+		as[*json.MarshalerError](err):
+		return codes.Internal
+	}
+	return codes.Unknown
+}
+
+func as[T error](err error) bool {
+	_, ok := errcode.As[T](err)
+	return ok
+}
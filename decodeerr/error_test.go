@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package decodeerr
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestErrorCoder(t *testing.T) {
+	decode := func(fn func() error) error {
+		t.Helper()
+		err := fn()
+		if err == nil {
+			t.Fatal("decoding succeeded unexpectedly")
+		}
+		return err
+	}
+	var n int
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "json syntax", Err: decode(func() error { return json.Unmarshal([]byte("{"), &n) }), Want: codes.InvalidArgument},
+		{Name: "json type", Err: decode(func() error { return json.Unmarshal([]byte(`"x"`), &n) }), Want: codes.InvalidArgument},
+		{Name: "json non-pointer", Err: &json.InvalidUnmarshalError{Type: reflect.TypeOf(n)}, Want: codes.Internal},
+		{Name: "json unsupported", Err: decode(func() error { _, err := json.Marshal(make(chan int)); return err }), Want: codes.Internal},
+		{Name: "xml syntax", Err: decode(func() error { return xml.Unmarshal([]byte("<a>"), &n) }), Want: codes.InvalidArgument},
+		{Name: "base64", Err: decode(func() error { _, err := base64.StdEncoding.DecodeString("!"); return err }), Want: codes.InvalidArgument},
+		{Name: "hex", Err: decode(func() error { _, err := hex.DecodeString("zz"); return err }), Want: codes.InvalidArgument},
+		{Name: "hex length", Err: decode(func() error { _, err := hex.DecodeString("abc"); return err }), Want: codes.InvalidArgument},
+		{Name: "strconv", Err: decode(func() error { _, err := strconv.Atoi("x"); return err }), Want: codes.InvalidArgument},
+		{Name: "proto", Err: decode(func() error { return proto.Unmarshal([]byte{0xff}, &wrapperspb.Int64Value{}) }), Want: codes.InvalidArgument},
+	})
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import "google.golang.org/grpc/codes"
+
+// A DualError is an error with both an explicit code and an explicit HTTP
+// status code. Handlers that serve both protocols use the code for gRPC and
+// the HTTP status code for HTTP, instead of deriving one from the other.
+type DualError interface {
+	Code() codes.Code
+	HTTPCode() int
+	error
+}
+
+// NewDual wraps the given error and adds both an explicit code and an explicit
+// HTTP status code. It's useful when the canonical mapping between them is
+// ambiguous. For example, a conflict may be reported as Aborted to gRPC clients,
+// so they retry it, and as 409 Conflict to HTTP clients:
+//
+//	errcode.NewDual(codes.Aborted, http.StatusConflict, err)
+func NewDual(code codes.Code, httpCode int, err error) error {
+	return &dualError{code, httpCode, err}
+}
+
+type dualError struct {
+	code     codes.Code
+	httpCode int
+	err      error
+}
+
+func (de *dualError) Code() codes.Code { return de.code }
+func (de *dualError) HTTPCode() int    { return de.httpCode }
+func (de *dualError) Error() string    { return de.err.Error() }
+func (de *dualError) Unwrap() error    { return de.err }
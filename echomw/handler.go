@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package echomw provides a github.com/labstack/echo/v4 error handler that
+// writes responses with statuses derived from the codes of errors.
+package echomw
+
+import (
+	"net/http"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"bursavich.dev/errcode/httpmw"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Echo ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an *echo.HTTPError.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*echo.HTTPError](err); ok {
+		return httperr.ToGRPC(e.Code)
+	}
+	return codes.Unknown
+}
+
+// An Option configures the error handler.
+type Option func(*options)
+
+type options struct {
+	messageFn func(codes.Code, error) string
+	renderFn  func(echo.Context, int, *httpmw.ErrorBody) error
+}
+
+// WithMessageFunc returns an Option that sets the function used to build the
+// message of an error response, like httpmw.WithMessageFunc.
+func WithMessageFunc(fn func(code codes.Code, err error) string) Option {
+	return func(o *options) { o.messageFn = fn }
+}
+
+// WithRenderFunc returns an Option that sets the function used to write an
+// error response with the given status and body. By default, the body is
+// written as JSON.
+func WithRenderFunc(fn func(c echo.Context, status int, body *httpmw.ErrorBody) error) Option {
+	return func(o *options) { o.renderFn = fn }
+}
+
+// ErrorHandler returns an echo.HTTPErrorHandler that writes an error response
+// with the status derived from the code resolved by the given ErrorCoder, unless
+// a response has already been committed. The status of an *echo.HTTPError is
+// kept if it's consistent with the resolved code, and its message is used as
+// the response's message if it's a string.
+//
+// It is installed by setting the Echo instance's HTTPErrorHandler field.
+func ErrorHandler(coder errcode.ErrorCoder, opts ...Option) echo.HTTPErrorHandler {
+	o := &options{
+		renderFn: func(c echo.Context, status int, body *httpmw.ErrorBody) error {
+			return c.JSON(status, body)
+		},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	coder = errcode.Compact(errcode.CodedErrorCoder(), coder, errorCoder)
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+		code := errcode.ResolveError(coder, err)
+		body := httpmw.NewErrorBody(code, err, o.messageFn)
+		reported := 0
+		if e, ok := errcode.As[*echo.HTTPError](err); ok {
+			reported = e.Code
+			if s, ok := e.Message.(string); ok && e == err {
+				body.Message = s
+			}
+		}
+		status := httpmw.Status(code, err, reported)
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(status)
+		} else {
+			err = o.renderFn(c, status, body)
+		}
+		if err != nil {
+			c.Logger().Error(err)
+		}
+	}
+}
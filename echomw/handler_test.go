@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package echomw
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httpmw"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{echo.ErrNotFound, codes.NotFound},
+		{fmt.Errorf("bind: %w", echo.NewHTTPError(http.StatusBadRequest, "bad")), codes.InvalidArgument},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		opts   []Option
+		status int
+		body   httpmw.ErrorBody
+	}{
+		{
+			name:   "coded",
+			err:    fmt.Errorf("open: %w", fs.ErrNotExist),
+			status: http.StatusNotFound,
+			body:   httpmw.ErrorBody{Code: "NOT_FOUND", Message: "open: file does not exist"},
+		},
+		{
+			name:   "http status",
+			err:    echo.ErrMethodNotAllowed,
+			status: http.StatusMethodNotAllowed,
+			body:   httpmw.ErrorBody{Code: "UNKNOWN", Message: "Method Not Allowed"},
+		},
+		{
+			name:   "http message",
+			err:    echo.NewHTTPError(http.StatusConflict, "taken"),
+			status: http.StatusConflict,
+			body:   httpmw.ErrorBody{Code: "ABORTED", Message: "taken"},
+		},
+		{
+			name:   "dual",
+			err:    errcode.NewDual(codes.Unavailable, http.StatusTooManyRequests, errors.New("busy")),
+			status: http.StatusTooManyRequests,
+			body:   httpmw.ErrorBody{Code: "UNAVAILABLE", Message: "service unavailable"},
+		},
+		{
+			name:   "public",
+			err:    errcode.WithPublicMessage(errcode.New(codes.Internal, errors.New("db: secret")), "try again"),
+			opts:   []Option{WithMessageFunc(func(codes.Code, error) string { return "redacted" })},
+			status: http.StatusInternalServerError,
+			body:   httpmw.ErrorBody{Code: "INTERNAL", Message: "try again"},
+		},
+		{
+			name:   "message func",
+			err:    errcode.New(codes.Internal, errors.New("db: secret")),
+			opts:   []Option{WithMessageFunc(func(codes.Code, error) string { return "redacted" })},
+			status: http.StatusInternalServerError,
+			body:   httpmw.ErrorBody{Code: "INTERNAL", Message: "redacted"},
+		},
+		{
+			name:   "reason",
+			err:    errcode.WithRequestID(errcode.WithReason(errcode.New(codes.ResourceExhausted, errors.New("slow down")), "example.com", "RATE_LIMITED"), "req-1"),
+			status: http.StatusTooManyRequests,
+			body: httpmw.ErrorBody{
+				Code:      "RESOURCE_EXHAUSTED",
+				Message:   "slow down",
+				Reason:    "RATE_LIMITED",
+				Domain:    "example.com",
+				RequestID: "req-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			w := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), w)
+			ErrorHandler(errcode.FileSystemErrorCoder(), tt.opts...)(tt.err, c)
+			if w.Code != tt.status {
+				t.Errorf("status: got %d; want %d", w.Code, tt.status)
+			}
+			var body httpmw.ErrorBody
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid body %q: %v", w.Body, err)
+			}
+			if body != tt.body {
+				t.Errorf("body: got %+v; want %+v", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestErrorHandlerCommitted(t *testing.T) {
+	e := echo.New()
+	w := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), w)
+	if err := c.NoContent(http.StatusAccepted); err != nil {
+		t.Fatal(err)
+	}
+	ErrorHandler(errcode.FileSystemErrorCoder())(fs.ErrNotExist, c)
+	if w.Code != http.StatusAccepted || w.Body.Len() != 0 {
+		t.Errorf("response: got %d %q; want %d with no body", w.Code, w.Body, http.StatusAccepted)
+	}
+}
+
+func TestErrorHandlerHead(t *testing.T) {
+	e := echo.New()
+	w := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodHead, "/", nil), w)
+	ErrorHandler(errcode.FileSystemErrorCoder())(fs.ErrNotExist, c)
+	if w.Code != http.StatusNotFound || w.Body.Len() != 0 {
+		t.Errorf("response: got %d %q; want %d with no body", w.Code, w.Body, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package errcatalog provides catalogs of localized error messages that are
+// selected by the codes and reasons of errors, so that clients in different
+// languages get translated messages driven by the same classification.
+//
+// Messages are text/template templates, which are executed with Data.
+//
+//	c := errcatalog.New(coder, "en")
+//	c.Add("en", codes.NotFound, "", "The requested resource wasn't found.")
+//	c.Add("fr", codes.NotFound, "", "La ressource demandée est introuvable.")
+//	c.Add("en", codes.ResourceExhausted, "RATE_LIMIT_EXCEEDED", "Slow down! Your limit is {{.Metadata.limit}}.")
+//
+//	msg, ok := c.Render(err, r.Header.Get("Accept-Language"))
+package errcatalog
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// Data is the data with which the template of a message is executed.
+// It doesn't include the error's message, which may describe internal details.
+type Data struct {
+	// Code is the error's code.
+	Code codes.Code
+	// Reason is the error's reason, if any.
+	Reason string
+	// Metadata is the error's metadata, as reported by errcode.Metadata,
+	// or else the metadata of the ErrorInfo detail of its gRPC status, if any.
+	Metadata map[string]string
+}
+
+type key struct {
+	lang   string
+	code   codes.Code
+	reason string
+}
+
+// A Catalog is a set of localized error message templates. Its messages must
+// be added before it's used to render messages, which may be done concurrently.
+type Catalog struct {
+	coder       errcode.ErrorCoder
+	defaultLang string
+	messages    map[key]*template.Template
+}
+
+// New returns a new Catalog that resolves the codes of errors with the given
+// ErrorCoder and falls back to the given default language.
+func New(coder errcode.ErrorCoder, defaultLang string) *Catalog {
+	return &Catalog{
+		coder:       coder,
+		defaultLang: normalizeLang(defaultLang),
+		messages:    make(map[key]*template.Template),
+	}
+}
+
+// Add adds the message template of errors with the given code and reason in the
+// given language. An error's reason is resolved as by grpcerr.Reason.
+// If the reason is empty, the message is used for errors with the code
+// whose reasons don't have their own messages. The language is a BCP 47 tag,
+// such as "en" or "pt-BR".
+func (c *Catalog) Add(lang string, code codes.Code, reason, text string) error {
+	lang = normalizeLang(lang)
+	if lang == "" {
+		return errors.New("errcatalog: empty language")
+	}
+	name := fmt.Sprintf("%s/%s/%s", lang, errcode.CodeString(code), reason)
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return fmt.Errorf("errcatalog: %w", err)
+	}
+	c.messages[key{lang, code, reason}] = tmpl
+	return nil
+}
+
+// Render returns the message of the error in the best available language and
+// reports whether the catalog has one. The language may be a BCP 47 tag or a
+// list of them with optional quality values, as in an Accept-Language header.
+//
+// Each requested language is tried in order of preference, followed by its
+// more general forms, such as "pt" for "pt-BR", and then the default language.
+// In each language, a message for the error's code and reason is preferred
+// to one for its code alone.
+func (c *Catalog) Render(err error, lang string) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+	data := &Data{Code: errcode.ResolveError(c.coder, err)}
+	_, data.Reason, _ = grpcerr.Reason(err)
+	if data.Metadata = errcode.Metadata(err); data.Metadata == nil {
+		data.Metadata = grpcerr.ErrorInfo(err).GetMetadata()
+	}
+	for _, lang := range append(parseLangs(lang), c.defaultLang) {
+		for ; lang != ""; lang = parentLang(lang) {
+			if msg, ok := c.render(key{lang, data.Code, data.Reason}, data); ok {
+				return msg, true
+			}
+			if msg, ok := c.render(key{lang, data.Code, ""}, data); ok {
+				return msg, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (c *Catalog) render(k key, data *Data) (string, bool) {
+	tmpl, ok := c.messages[k]
+	if !ok {
+		return "", false
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", false
+	}
+	return b.String(), true
+}
+
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+// parentLang returns the more general form of the language,
+// such as "zh-hant" for "zh-hant-tw", or an empty string.
+func parentLang(lang string) string {
+	if i := strings.LastIndexByte(lang, '-'); i > 0 {
+		return lang[:i]
+	}
+	return ""
+}
+
+// parseLangs returns the languages of an Accept-Language header
+// in order of preference, excluding those with a quality of zero.
+func parseLangs(header string) []string {
+	type weighted struct {
+		lang string
+		q    float64
+	}
+	var list []weighted
+	for _, part := range strings.Split(header, ",") {
+		lang, params, _ := strings.Cut(part, ";")
+		lang = normalizeLang(lang)
+		if lang == "" || lang == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			list = append(list, weighted{lang, q})
+		}
+	}
+	slices.SortStableFunc(list, func(a, b weighted) int { return cmp.Compare(b.q, a.q) })
+	langs := make([]string, len(list))
+	for i, w := range list {
+		langs[i] = w.lang
+	}
+	return langs
+}
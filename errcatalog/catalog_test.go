@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcatalog
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRender(t *testing.T) {
+	c := New(errcode.Compact(grpcerr.ErrorCoder(), errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder()), "en")
+	for _, m := range []struct {
+		lang   string
+		code   codes.Code
+		reason string
+		text   string
+	}{
+		{"en", codes.NotFound, "", "Not found."},
+		{"fr", codes.NotFound, "", "Introuvable."},
+		{"pt-BR", codes.NotFound, "", "Não encontrado."},
+		{"en", codes.ResourceExhausted, "", "Too many requests."},
+		{"en", codes.ResourceExhausted, "RATE_LIMIT_EXCEEDED", "Slow down! Your limit is {{.Metadata.limit}} per {{.Metadata.unit}}."},
+		{"en", codes.Unknown, "", "Something went wrong ({{.Code}})."},
+	} {
+		if err := c.Add(m.lang, m.code, m.reason, m.text); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, _ := status.New(codes.ResourceExhausted, "quota").WithDetails(&errdetails.ErrorInfo{
+		Reason:   "RATE_LIMIT_EXCEEDED",
+		Metadata: map[string]string{"limit": "10", "unit": "minute"},
+	})
+	notFound := fmt.Errorf("open: %w", fs.ErrNotExist)
+	tests := []struct {
+		err  error
+		lang string
+		want string
+		ok   bool
+	}{
+		{nil, "en", "", false},
+		{notFound, "fr", "Introuvable.", true},
+		{notFound, "fr-CA", "Introuvable.", true},
+		{notFound, "pt_BR", "Não encontrado.", true},
+		{notFound, "de", "Not found.", true},
+		{notFound, "de, fr;q=0.5, en;q=0.8", "Not found.", true},
+		{notFound, "de, fr;q=0.9, en;q=0.8", "Introuvable.", true},
+		{notFound, "fr;q=0, *", "Not found.", true},
+		{s.Err(), "fr", "Slow down! Your limit is 10 per minute.", true},
+		{status.Error(codes.ResourceExhausted, "quota"), "en", "Too many requests.", true},
+		{errcode.WithReason(errcode.New(codes.ResourceExhausted, errors.New("quota")), "example.com", "RATE_LIMIT_EXCEEDED"), "en", "Slow down! Your limit is  per .", true},
+		{errcode.WithMetadata(errcode.WithReason(errcode.New(codes.ResourceExhausted, errors.New("quota")), "example.com", "RATE_LIMIT_EXCEEDED"), "limit", "5"), "en", "Slow down! Your limit is 5 per .", true},
+		{errors.New("boom"), "en", "Something went wrong (Unknown).", true},
+		{errcode.New(codes.Internal, errors.New("boom")), "en", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := c.Render(tt.err, tt.lang); got != tt.want || ok != tt.ok {
+			t.Errorf("Render(%v, %q): got (%q, %v); want (%q, %v)", tt.err, tt.lang, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestAddErrors(t *testing.T) {
+	c := New(errcode.CodedErrorCoder(), "en")
+	if err := c.Add("", codes.NotFound, "", "Not found."); err == nil {
+		t.Error("expected an error for an empty language")
+	}
+	if err := c.Add("en", codes.NotFound, "", "{{.Code"); err == nil {
+		t.Error("expected an error for an invalid template")
+	}
+}
+
+func TestParseLangs(t *testing.T) {
+	got := parseLangs("en-US;q=0.8, fr, de;q=0, *;q=0.1, es;q=0.9")
+	if want := []string{"fr", "es", "en-us"}; !slices.Equal(got, want) {
+		t.Errorf("parseLangs: got %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package errcodeanalysis defines an Analyzer that reports errors returned
+// without a status code from the exported functions of a package.
+//
+// A package opts in by including the directive
+//
+//	//errcode:enforce
+//
+// in any of its files, typically in a package doc comment. In such a package,
+// every return statement of an exported function or method whose last result
+// is an error is checked, and the analyzer reports returned errors that are:
+//
+//   - created by errors.New;
+//   - created by fmt.Errorf without wrapping another error with %w;
+//   - package-level variables initialized by either, which are sentinel
+//     errors.
+//
+// Sentinel errors that are mapped to codes elsewhere, such as by an
+// errcode.ErrorCoder or a configcoder rule, may be marked with the directive
+//
+//	//errcode:coded
+//
+// in the doc or line comment of their declaration, which suppresses reports
+// of their returns. A directive in the doc comment of a grouped declaration
+// applies to every variable in the group.
+//
+// Errors that wrap other errors with %w are assumed to carry the codes
+// of the errors they wrap. Errors created by other functions, such as
+// errcode.New, aren't reported.
+//
+// The analyzer may be run by go vet with the errcodeanalysis command:
+//
+//	go vet -vettool=$(which errcodeanalysis) ./...
+package errcodeanalysis
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Directive is the comment with which a package opts in to the analysis.
+const Directive = "//errcode:enforce"
+
+// CodedDirective is the comment with which a sentinel error is marked as
+// mapped to a code.
+const CodedDirective = "//errcode:coded"
+
+// Analyzer reports errors returned without a status code from the
+// exported functions of the packages that opt in to the analysis.
+var Analyzer = &analysis.Analyzer{
+	Name:     "errcode",
+	Doc:      "report errors returned without a status code from exported functions",
+	URL:      "https://pkg.go.dev/bursavich.dev/errcode/errcodeanalysis",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var errorType = types.Universe.Lookup("error").Type()
+
+func run(pass *analysis.Pass) (any, error) {
+	if !enforced(pass.Files) {
+		return nil, nil
+	}
+	sentinels := rawSentinels(pass)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		fn := n.(*ast.FuncDecl)
+		if fn.Body == nil || !fn.Name.IsExported() || !returnsError(pass, fn) {
+			return
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				// Returns of function literals aren't returns of the function.
+				return false
+			case *ast.ReturnStmt:
+				if len(n.Results) == 0 {
+					return false
+				}
+				checkResult(pass, sentinels, n.Results[len(n.Results)-1])
+			}
+			return true
+		})
+	})
+	return nil, nil
+}
+
+// enforced reports whether any of the files contains the directive.
+func enforced(files []*ast.File) bool {
+	for _, f := range files {
+		for _, g := range f.Comments {
+			if hasDirective(g, Directive) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasDirective reports whether the comment group contains the directive.
+func hasDirective(g *ast.CommentGroup, directive string) bool {
+	if g == nil {
+		return false
+	}
+	for _, c := range g.List {
+		if strings.TrimSpace(c.Text) == directive {
+			return true
+		}
+	}
+	return false
+}
+
+// returnsError reports whether the last result of the function is an error.
+func returnsError(pass *analysis.Pass, fn *ast.FuncDecl) bool {
+	obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return false
+	}
+	res := obj.Type().(*types.Signature).Results()
+	return res.Len() > 0 && types.Identical(res.At(res.Len()-1).Type(), errorType)
+}
+
+// rawSentinels returns the package-level error variables that are
+// initialized without a code and aren't marked as coded.
+func rawSentinels(pass *analysis.Pass) map[types.Object]bool {
+	coded := codedVars(pass)
+	sentinels := make(map[types.Object]bool)
+	for _, init := range pass.TypesInfo.InitOrder {
+		if len(init.Lhs) != 1 || !raw(pass, init.Rhs) {
+			continue
+		}
+		if v := init.Lhs[0]; types.Identical(v.Type(), errorType) && !coded[v] {
+			sentinels[v] = true
+		}
+	}
+	return sentinels
+}
+
+// codedVars returns the package-level variables marked with the CodedDirective.
+func codedVars(pass *analysis.Pass) map[types.Object]bool {
+	coded := make(map[types.Object]bool)
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			group := hasDirective(gen.Doc, CodedDirective)
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.ValueSpec)
+				if !group && !hasDirective(spec.Doc, CodedDirective) && !hasDirective(spec.Comment, CodedDirective) {
+					continue
+				}
+				for _, name := range spec.Names {
+					coded[pass.TypesInfo.Defs[name]] = true
+				}
+			}
+		}
+	}
+	return coded
+}
+
+func checkResult(pass *analysis.Pass, sentinels map[types.Object]bool, expr ast.Expr) {
+	expr = ast.Unparen(expr)
+	if raw(pass, expr) {
+		pass.ReportRangef(expr, "error returned without a status code; use errcode.New or wrap a coded error")
+		return
+	}
+	if id, ok := expr.(*ast.Ident); ok && sentinels[pass.TypesInfo.Uses[id]] {
+		pass.ReportRangef(expr, "sentinel error %s returned without a status code; wrap a coded error, or map it to a code and mark it %s", id.Name, CodedDirective)
+	}
+}
+
+// raw reports whether the expression is a call to errors.New or
+// a call to fmt.Errorf that doesn't wrap an error.
+func raw(pass *analysis.Pass, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+	switch fn.Pkg().Path() + "." + fn.Name() {
+	case "errors.New":
+		return true
+	case "fmt.Errorf":
+		return len(call.Args) > 0 && !wraps(pass, call.Args[0])
+	}
+	return false
+}
+
+// wraps reports whether the format may wrap an error with %w.
+// A format that isn't constant is assumed to wrap an error.
+func wraps(pass *analysis.Pass, format ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[format]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return true
+	}
+	return strings.Contains(strings.ReplaceAll(constant.StringVal(tv.Value), "%%", ""), "%w")
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Command errcodeanalysis reports errors returned without a status code
+// from the exported functions of the packages that opt in to the analysis.
+// It may be run directly or by go vet:
+//
+//	go vet -vettool=$(which errcodeanalysis) ./...
+package main
+
+import (
+	"bursavich.dev/errcode/errcodeanalysis"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() { singlechecker.Main(errcodeanalysis.Analyzer) }
@@ -0,0 +1,12 @@
+module bursavich.dev/errcode/errcodeanalysis
+
+go 1.24.0
+
+require golang.org/x/tools v0.42.0
+
+require (
+	golang.org/x/mod v0.33.0 // indirect
+	golang.org/x/sync v0.19.0 // indirect
+)
+
+replace bursavich.dev/errcode => ../
@@ -0,0 +1,87 @@
+// Package a opts in to the analysis.
+//
+//errcode:enforce
+package a
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrRaw     = errors.New("raw")
+	ErrWrapped = fmt.Errorf("wrapped: %w", ErrRaw)
+	ErrMapped  = errors.New("mapped") //errcode:coded
+
+	// ErrDoc is mapped to a code by a coder.
+	//
+	//errcode:coded
+	ErrDoc = errors.New("doc")
+)
+
+//errcode:coded
+var ErrSingle = errors.New("single")
+
+//errcode:coded
+var (
+	ErrGroupA = errors.New("group a")
+	ErrGroupB = errors.New("group b")
+)
+
+type codedError struct{}
+
+func (codedError) Error() string { return "coded" }
+
+func New() error { return codedError{} }
+
+func Raw() error {
+	return errors.New("raw") // want `error returned without a status code`
+}
+
+func Format(n int) (int, error) {
+	return 0, fmt.Errorf("bad %d", n) // want `error returned without a status code`
+}
+
+func Sentinel() error {
+	return ErrRaw // want `sentinel error ErrRaw returned without a status code`
+}
+
+func Mapped(n int) error {
+	switch n {
+	case 0:
+		return ErrMapped
+	case 1:
+		return ErrDoc
+	case 2:
+		return ErrSingle
+	case 3:
+		return ErrGroupA
+	}
+	return ErrGroupB
+}
+
+func Wrap(err error) error {
+	if err != nil {
+		return fmt.Errorf("wrap: %w", err)
+	}
+	return ErrWrapped
+}
+
+func Coded() error {
+	return New()
+}
+
+func Literal() error {
+	fn := func() error { return errors.New("ignored") }
+	return fn()
+}
+
+func unexported() error {
+	return errors.New("ignored")
+}
+
+type T struct{}
+
+func (T) Method() error {
+	return (errors.New("raw")) // want `error returned without a status code`
+}
@@ -0,0 +1,8 @@
+// Package b doesn't opt in to the analysis.
+package b
+
+import "errors"
+
+func Raw() error {
+	return errors.New("raw")
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcodetest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+var errUnrecognized = errors.New("errcodetest: unrecognized error")
+
+// cycleTimeout is how long a coder may take to resolve an error tree with a cycle.
+const cycleTimeout = 10 * time.Second
+
+// cycleError is a buggy error whose Unwrap method may create a cycle.
+type cycleError struct{ next error }
+
+func (e *cycleError) Error() string { return "errcodetest: cycle" }
+func (e *cycleError) Unwrap() error { return e.next }
+
+// newCycle returns an error with two nodes that unwrap to each other.
+func newCycle() error {
+	a, b := &cycleError{}, &cycleError{}
+	a.next, b.next = b, a
+	return a
+}
+
+// joinCycleError is a buggy error whose Unwrap method returns itself twice.
+type joinCycleError struct{}
+
+func (e *joinCycleError) Error() string   { return "errcodetest: join cycle" }
+func (e *joinCycleError) Unwrap() []error { return []error{e, e} }
+
+func newJoinCycle() error { return &joinCycleError{} }
+
+// TestErrorCoder runs a conformance suite that verifies the coder meets the
+// contract of the ErrorCoder interface, using fixtures of errors that it
+// recognizes. It verifies that:
+//
+//   - a nil error resolves to OK;
+//   - an unrecognized error resolves to Unknown;
+//   - each fixture resolves to its code, even when it's wrapped by
+//     fmt.Errorf or joined with an unrecognized error by errors.Join;
+//   - an error tree with a cycle, created by buggy Unwrap methods,
+//     resolves to Unknown rather than hang;
+//   - the coder may be used by multiple goroutines simultaneously,
+//     which is best verified with the race detector.
+func TestErrorCoder(t *testing.T, coder errcode.ErrorCoder, fixtures []Case) {
+	t.Helper()
+	t.Run("nil", func(t *testing.T) {
+		if got := coder.ErrorCode(nil); got != codes.OK {
+			t.Errorf("ErrorCode(nil): got %v; want %v", got, codes.OK)
+		}
+	})
+	t.Run("unrecognized", func(t *testing.T) {
+		if got := coder.ErrorCode(errUnrecognized); got != codes.Unknown {
+			t.Errorf("ErrorCode(%v): got %v; want %v", errUnrecognized, got, codes.Unknown)
+		}
+	})
+	t.Run("fixtures", func(t *testing.T) {
+		RunCoderTests(t, coder, fixtures)
+	})
+	t.Run("wrapped", func(t *testing.T) {
+		for _, tc := range fixtures {
+			if tc.Err == nil {
+				continue
+			}
+			AssertCode(t, fmt.Errorf("wrapped: %w", tc.Err), tc.Want, coder)
+			AssertCode(t, fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", tc.Err)), tc.Want, coder)
+			AssertCode(t, errors.Join(errUnrecognized, tc.Err), tc.Want, coder)
+		}
+	})
+	t.Run("cycle", func(t *testing.T) {
+		for _, err := range []error{newCycle(), newJoinCycle()} {
+			done := make(chan codes.Code, 1)
+			go func() { done <- coder.ErrorCode(err) }()
+			select {
+			case got := <-done:
+				if got != codes.Unknown {
+					t.Errorf("ErrorCode(%v): got %v; want %v", err, got, codes.Unknown)
+				}
+			case <-time.After(cycleTimeout):
+				t.Fatalf("ErrorCode(%v): timed out after %v", err, cycleTimeout)
+			}
+		}
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		const goroutines = 8
+		var wg sync.WaitGroup
+		for range goroutines {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for _, tc := range fixtures {
+					if got := coder.ErrorCode(tc.Err); got != tc.Want {
+						t.Errorf("ErrorCode(%v): got %v; want %v", tc.Err, got, tc.Want)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
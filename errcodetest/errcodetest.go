@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package errcodetest provides helpers for testing the codes resolved
+// by ErrorCoders, both in ErrorCoder implementations and in the services
+// that use them.
+package errcodetest
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// AssertCode reports a test error if the error's code resolved by the coders
+// isn't the wanted code.
+func AssertCode(t testing.TB, err error, want codes.Code, coders ...errcode.ErrorCoder) bool {
+	t.Helper()
+	if got := errcode.Compact(coders...).ErrorCode(err); got != want {
+		t.Errorf("ErrorCode(%v): got %v; want %v", err, got, want)
+		return false
+	}
+	return true
+}
+
+// RequireCode is like AssertCode, but stops the test if the code isn't the wanted code.
+func RequireCode(t testing.TB, err error, want codes.Code, coders ...errcode.ErrorCoder) {
+	t.Helper()
+	if !AssertCode(t, err, want, coders...) {
+		t.FailNow()
+	}
+}
+
+// RequireRetryable stops the test if the error's code resolved by the coders
+// isn't retryable, as reported by errcode.RetryableCode.
+func RequireRetryable(t testing.TB, err error, coders ...errcode.ErrorCoder) {
+	t.Helper()
+	if code := errcode.Compact(coders...).ErrorCode(err); !errcode.RetryableCode(code) {
+		t.Fatalf("ErrorCode(%v): got %v; want a retryable code", err, code)
+	}
+}
+
+// RequireNotRetryable stops the test if the error's code resolved by the coders
+// is retryable, as reported by errcode.RetryableCode.
+func RequireNotRetryable(t testing.TB, err error, coders ...errcode.ErrorCoder) {
+	t.Helper()
+	if code := errcode.Compact(coders...).ErrorCode(err); errcode.RetryableCode(code) {
+		t.Fatalf("ErrorCode(%v): got %v; want a code that isn't retryable", err, code)
+	}
+}
+
+// A Case is a test case of an ErrorCoder.
+type Case struct {
+	// Name is the name of the subtest. If empty, the error's message is used.
+	Name string
+	// Err is the classified error.
+	Err error
+	// Want is the wanted code.
+	Want codes.Code
+}
+
+// RunCoderTests runs a subtest for each case that asserts the code
+// resolved by the coder.
+func RunCoderTests(t *testing.T, coder errcode.ErrorCoder, cases []Case) {
+	t.Helper()
+	for _, tc := range cases {
+		name := tc.Name
+		if name == "" {
+			name = "nil"
+			if tc.Err != nil {
+				name = tc.Err.Error()
+			}
+		}
+		t.Run(name, func(t *testing.T) {
+			t.Helper()
+			AssertCode(t, tc.Err, tc.Want, coder)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcodetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// recorder is a testing.TB that records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	failed bool
+	fatal  bool
+}
+
+func (r *recorder) Helper()               {}
+func (r *recorder) Errorf(string, ...any) { r.failed = true }
+func (r *recorder) Fatalf(string, ...any) { r.failed, r.fatal = true, true }
+func (r *recorder) FailNow()              { r.failed, r.fatal = true, true }
+
+func TestAssertions(t *testing.T) {
+	unavailable := errcode.New(codes.Unavailable, errors.New("down"))
+	notFound := fmt.Errorf("open: %w", fs.ErrNotExist)
+	coders := []errcode.ErrorCoder{errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder()}
+
+	tests := []struct {
+		name   string
+		fn     func(testing.TB)
+		failed bool
+		fatal  bool
+	}{
+		{
+			name: "AssertCode",
+			fn:   func(t testing.TB) { AssertCode(t, notFound, codes.NotFound, coders...) },
+		},
+		{
+			name:   "AssertCode mismatch",
+			fn:     func(t testing.TB) { AssertCode(t, notFound, codes.Internal, coders...) },
+			failed: true,
+		},
+		{
+			name:   "RequireCode mismatch",
+			fn:     func(t testing.TB) { RequireCode(t, nil, codes.Internal, coders...) },
+			failed: true,
+			fatal:  true,
+		},
+		{
+			name: "RequireRetryable",
+			fn:   func(t testing.TB) { RequireRetryable(t, unavailable, coders...) },
+		},
+		{
+			name:   "RequireRetryable mismatch",
+			fn:     func(t testing.TB) { RequireRetryable(t, notFound, coders...) },
+			failed: true,
+			fatal:  true,
+		},
+		{
+			name:   "RequireNotRetryable mismatch",
+			fn:     func(t testing.TB) { RequireNotRetryable(t, unavailable, coders...) },
+			failed: true,
+			fatal:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			tt.fn(r)
+			if r.failed != tt.failed || r.fatal != tt.fatal {
+				t.Errorf("got (failed: %v, fatal: %v); want (failed: %v, fatal: %v)", r.failed, r.fatal, tt.failed, tt.fatal)
+			}
+		})
+	}
+}
+
+func TestRunCoderTests(t *testing.T) {
+	RunCoderTests(t, errcode.ContextErrorCoder(), []Case{
+		{Err: nil, Want: codes.OK},
+		{Err: context.Canceled, Want: codes.Canceled},
+		{Name: "wrapped deadline", Err: fmt.Errorf("call: %w", context.DeadlineExceeded), Want: codes.DeadlineExceeded},
+		{Err: errors.New("boom"), Want: codes.Unknown},
+	})
+}
+
+var (
+	conformanceCoder    = errcode.Compact(errcode.ContextErrorCoder(), errcode.FileSystemErrorCoder())
+	conformanceFixtures = []Case{
+		{Err: context.Canceled, Want: codes.Canceled},
+		{Err: context.DeadlineExceeded, Want: codes.DeadlineExceeded},
+		{Err: fs.ErrNotExist, Want: codes.NotFound},
+		{Err: fs.ErrPermission, Want: codes.PermissionDenied},
+	}
+)
+
+func TestConformance(t *testing.T) {
+	TestErrorCoder(t, conformanceCoder, conformanceFixtures)
+}
+
+func FuzzConformance(f *testing.F) {
+	FuzzErrorCoder(f, conformanceCoder, conformanceFixtures)
+}
+
+func TestChain(t *testing.T) {
+	err := Chain(make([]byte, 100), context.Canceled)
+	depth := 0
+	for ; err != nil; err = errors.Unwrap(err) {
+		depth++
+	}
+	if want := maxChainDepth + 1; depth != want {
+		t.Errorf("depth: got %d; want %d", depth, want)
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcodetest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode"
+)
+
+// maxChainDepth limits the depth of the chains built by Chain.
+const maxChainDepth = 64
+
+var errNoise = errors.New("errcodetest: noise")
+
+// wrapper is an error that wraps another without fmt.Errorf.
+type wrapper struct{ err error }
+
+func (w *wrapper) Error() string { return "wrapper: " + w.err.Error() }
+func (w *wrapper) Unwrap() error { return w.err }
+
+// Chain returns an error chain built from the data in which err is planted
+// at the bottom. Each byte of data adds a layer around the chain: an
+// fmt.Errorf wrapper, a custom wrapper, or an errors.Join with unrecognized
+// errors before or after it. Its depth is limited to 64 layers.
+func Chain(data []byte, err error) error {
+	for i, b := range data {
+		if i == maxChainDepth {
+			break
+		}
+		switch b % 4 {
+		case 0:
+			err = fmt.Errorf("layer %d: %w", i, err)
+		case 1:
+			err = &wrapper{err}
+		case 2:
+			err = errors.Join(errNoise, err)
+		case 3:
+			err = errors.Join(err, fmt.Errorf("layer %d: %w", i, errNoise))
+		}
+	}
+	return err
+}
+
+// FuzzErrorCoder adds seeds to the fuzz test's corpus and runs a fuzz target
+// that plants a fixture at the bottom of a random chain built by Chain, and
+// verifies that the coder doesn't panic and resolves the fixture's code.
+// The fixtures must not be nil.
+func FuzzErrorCoder(f *testing.F, coder errcode.ErrorCoder, fixtures []Case) {
+	f.Helper()
+	if len(fixtures) == 0 {
+		f.Fatal("errcodetest: no fixtures")
+	}
+	for i := range fixtures {
+		f.Add(uint(i), []byte{})
+		f.Add(uint(i), []byte{0, 1, 2, 3})
+		f.Add(uint(i), []byte{3, 3, 2, 1, 0, 0})
+	}
+	f.Fuzz(func(t *testing.T, i uint, data []byte) {
+		tc := fixtures[i%uint(len(fixtures))]
+		err := Chain(data, tc.Err)
+		if got := coder.ErrorCode(err); got != tc.Want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", err, got, tc.Want)
+		}
+	})
+}
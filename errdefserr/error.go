@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package errdefserr provides the ability to extract the status code from containerd
+// and Docker errors from the github.com/containerd/errdefs package.
+//
+// The errdefs predicates also recognize errors that implement its marker
+// interfaces (e.g. NotFound()), such as those returned by the Docker client.
+// Errors are mapped in the same way as containerd's errgrpc package.
+package errdefserr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/containerd/errdefs"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the errdefs ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it's recognized by the errdefs predicates.
+func ErrorCode(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case !errcode.WithinLimits(err):
+		// The errdefs predicates traverse the tree without limits.
+		return codes.Unknown
+	case errdefs.IsInvalidArgument(err):
+		return codes.InvalidArgument
+	case errdefs.IsNotFound(err):
+		return codes.NotFound
+	case errdefs.IsAlreadyExists(err):
+		return codes.AlreadyExists
+	case errdefs.IsFailedPrecondition(err), errdefs.IsConflict(err), errdefs.IsNotModified(err):
+		return codes.FailedPrecondition
+	case errdefs.IsUnavailable(err):
+		return codes.Unavailable
+	case errdefs.IsNotImplemented(err):
+		return codes.Unimplemented
+	case errdefs.IsCanceled(err):
+		return codes.Canceled
+	case errdefs.IsDeadlineExceeded(err):
+		return codes.DeadlineExceeded
+	case errdefs.IsUnauthorized(err):
+		return codes.Unauthenticated
+	case errdefs.IsPermissionDenied(err):
+		return codes.PermissionDenied
+	case errdefs.IsInternal(err):
+		return codes.Internal
+	case errdefs.IsDataLoss(err):
+		return codes.DataLoss
+	case errdefs.IsAborted(err):
+		return codes.Aborted
+	case errdefs.IsOutOfRange(err):
+		return codes.OutOfRange
+	case errdefs.IsResourceExhausted(err):
+		return codes.ResourceExhausted
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errdefserr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/containerd/errdefs"
+	"google.golang.org/grpc/codes"
+)
+
+// notFoundError implements the marker interface of Docker client errors.
+type notFoundError struct{}
+
+func (notFoundError) Error() string { return "No such container: web" }
+func (notFoundError) NotFound()     {}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: errdefs.ErrNotFound, Want: codes.NotFound},
+		{Name: "already exists", Err: errdefs.ErrAlreadyExists, Want: codes.AlreadyExists},
+		{Name: "permission denied", Err: errdefs.ErrPermissionDenied, Want: codes.PermissionDenied},
+		{Name: "unavailable", Err: errdefs.ErrUnavailable, Want: codes.Unavailable},
+		{Name: "not implemented", Err: errdefs.ErrNotImplemented, Want: codes.Unimplemented},
+		{Name: "conflict", Err: errdefs.ErrConflict, Want: codes.FailedPrecondition},
+		{Name: "invalid argument", Err: errdefs.ErrInvalidArgument, Want: codes.InvalidArgument},
+		{Name: "marker", Err: notFoundError{}, Want: codes.NotFound},
+	})
+}
@@ -9,7 +9,7 @@ package errcode
 
 import (
 	"context"
-	"errors"
+	"io"
 	"io/fs"
 	"slices"
 
@@ -57,6 +57,20 @@ func (e *errorCoderFn) ErrorCode(err error) codes.Code {
 	return e.fn(err)
 }
 
+// ResolveError returns the code of the error resolved by the given ErrorCoder.
+// A non-nil error is never reported as a success, so OK is replaced by Unknown.
+// If the error is nil, it returns OK.
+func ResolveError(coder ErrorCoder, err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if code := coder.ErrorCode(err); code != codes.OK {
+		return code
+	}
+	// A non-nil error must not be reported as a success.
+	return codes.Unknown
+}
+
 // ErrorCoders is an ErrorCoder that combines other ErrorCoders.
 type ErrorCoders []ErrorCoder
 
@@ -95,6 +109,30 @@ func contains(slice ErrorCoders, elem ErrorCoder) bool {
 	return slices.Contains(slice, elem)
 }
 
+type conditionalErrorCoder struct {
+	predicate func(error) bool
+	coder     ErrorCoder
+}
+
+// When returns an ErrorCoder that only applies the given coder
+// to errors that satisfy the predicate. For other errors it returns Unknown.
+//
+// It is useful for scoping aggressive coders, such as those that match on
+// error messages, to errors from a particular operation or package.
+func When(predicate func(error) bool, coder ErrorCoder) ErrorCoder {
+	return &conditionalErrorCoder{predicate, coder}
+}
+
+func (c *conditionalErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if !c.predicate(err) {
+		return codes.Unknown
+	}
+	return c.coder.ErrorCode(err)
+}
+
 var codedErrorCoder ErrorCoder = FromFunc(codedErrorCode)
 
 // CodedErrorCoder returns an ErrorCoder that handles CodedErrors.
@@ -106,8 +144,7 @@ func codedErrorCode(err error) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	var e Error
-	if errors.As(err, &e) {
+	if e, ok := As[Error](err); ok {
 		return e.Code()
 	}
 	return codes.Unknown
@@ -124,10 +161,10 @@ func contextErrorCode(err error) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	if errors.Is(err, context.DeadlineExceeded) {
+	if Is(err, context.DeadlineExceeded) {
 		return codes.DeadlineExceeded
 	}
-	if errors.Is(err, context.Canceled) {
+	if Is(err, context.Canceled) {
 		return codes.Canceled
 	}
 	return codes.Unknown
@@ -144,17 +181,105 @@ func fsErrorCode(err error) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	if errors.Is(err, fs.ErrExist) {
+	if Is(err, fs.ErrExist) {
 		return codes.AlreadyExists
 	}
-	if errors.Is(err, fs.ErrNotExist) {
+	if Is(err, fs.ErrNotExist) {
 		return codes.NotFound
 	}
-	if errors.Is(err, fs.ErrPermission) {
+	if Is(err, fs.ErrPermission) {
 		return codes.PermissionDenied
 	}
-	if errors.Is(err, fs.ErrInvalid) {
+	if Is(err, fs.ErrInvalid) {
 		return codes.InvalidArgument
 	}
 	return codes.Unknown
 }
+
+var transientErrorCoder ErrorCoder = FromFunc(transientErrorCode)
+
+// TransientErrorCoder returns an ErrorCoder that handles errors with Timeout or Temporary methods:
+//
+//	Timeout() bool
+//	Temporary() bool
+//
+// Timeouts are mapped to DeadlineExceeded and temporary errors are mapped to Unavailable.
+// Many errors from the net package and database drivers only expose these methods.
+func TransientErrorCoder() ErrorCoder {
+	return transientErrorCoder
+}
+
+type timeoutError interface {
+	Timeout() bool
+	error
+}
+
+type temporaryError interface {
+	Temporary() bool
+	error
+}
+
+func transientErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := As[timeoutError](err); ok && e.Timeout() {
+		return codes.DeadlineExceeded
+	}
+	if e, ok := As[temporaryError](err); ok && e.Temporary() {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+var ioErrorCoder ErrorCoder = IOErrorCoderWithEOF(codes.OutOfRange)
+
+// IOErrorCoder returns an ErrorCoder that handles io errors:
+//
+//	io.EOF              => OutOfRange
+//	io.ErrUnexpectedEOF => Unavailable
+//	io.ErrClosedPipe    => Unavailable
+//	io.ErrShortWrite    => Internal
+//	io.ErrNoProgress    => Internal
+//
+// An unexpected EOF is most often caused by a peer that closed its connection
+// in the middle of a message, so it is treated as a transient failure rather than
+// as DataLoss. A short write or a lack of progress indicates a broken io.Writer
+// or io.Reader implementation.
+//
+// The meaning of io.EOF depends on its context. Reading past the end of a file
+// is OutOfRange, but a stream that ends early may not be. See IOErrorCoderWithEOF.
+func IOErrorCoder() ErrorCoder {
+	return ioErrorCoder
+}
+
+// IOErrorCoderWithEOF returns an ErrorCoder that handles io errors like IOErrorCoder,
+// except that io.EOF is mapped to the given code. If the code is Unknown, io.EOF
+// is left unhandled for other ErrorCoders.
+func IOErrorCoderWithEOF(eof codes.Code) ErrorCoder {
+	return FromFunc(func(err error) codes.Code {
+		if err == nil {
+			return codes.OK
+		}
+		if eof != codes.Unknown && Is(err, io.EOF) {
+			return eof
+		}
+		return ioErrorCode(err)
+	})
+}
+
+func ioErrorCode(err error) codes.Code {
+	if Is(err, io.ErrUnexpectedEOF) {
+		return codes.Unavailable
+	}
+	if Is(err, io.ErrClosedPipe) {
+		return codes.Unavailable
+	}
+	if Is(err, io.ErrShortWrite) {
+		return codes.Internal
+	}
+	if Is(err, io.ErrNoProgress) {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
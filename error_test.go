@@ -7,9 +7,16 @@
 package errcode
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
 	"reflect"
 	"slices"
 	"testing"
+
+	"google.golang.org/grpc/codes"
 )
 
 func TestCompact(t *testing.T) {
@@ -23,3 +30,119 @@ func TestCompact(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestWhen(t *testing.T) {
+	errMatch := errors.New("match")
+	coder := When(
+		func(err error) bool { return errors.Is(err, errMatch) },
+		FromFunc(func(error) codes.Code { return codes.NotFound }),
+	)
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errMatch, codes.NotFound},
+		{fmt.Errorf("wrapped: %w", errMatch), codes.NotFound},
+		{errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := coder.ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestResolveError(t *testing.T) {
+	ok := FromFunc(func(error) codes.Code { return codes.OK })
+	tests := []struct {
+		coder ErrorCoder
+		err   error
+		want  codes.Code
+	}{
+		{ok, nil, codes.OK},
+		{ok, errors.New("boom"), codes.Unknown},
+		{CodedErrorCoder(), New(codes.NotFound, errors.New("missing")), codes.NotFound},
+		{CodedErrorCoder(), errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ResolveError(tt.coder, tt.err); got != tt.want {
+			t.Errorf("ResolveError(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := FromContext(ctx); ok {
+		t.Fatal("FromContext: unexpected ErrorCoder")
+	}
+	want := ContextErrorCoder()
+	got, ok := FromContext(NewContext(ctx, want))
+	if !ok || got != want {
+		t.Fatalf("FromContext: got (%v, %v); want (%v, true)", got, ok, want)
+	}
+}
+
+type transientError struct {
+	timeout, temporary bool
+}
+
+func (e *transientError) Error() string   { return "transient" }
+func (e *transientError) Timeout() bool   { return e.timeout }
+func (e *transientError) Temporary() bool { return e.temporary }
+
+func TestTransientErrorCoder(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{os.ErrDeadlineExceeded, codes.DeadlineExceeded},
+		{fmt.Errorf("wrapped: %w", &transientError{timeout: true, temporary: true}), codes.DeadlineExceeded},
+		{&transientError{temporary: true}, codes.Unavailable},
+		{&transientError{}, codes.Unknown},
+		{errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := TransientErrorCoder().ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestIOErrorCoder(t *testing.T) {
+	tests := []struct {
+		coder ErrorCoder
+		err   error
+		want  codes.Code
+	}{
+		{IOErrorCoder(), nil, codes.OK},
+		{IOErrorCoder(), io.EOF, codes.OutOfRange},
+		{IOErrorCoder(), fmt.Errorf("read: %w", io.ErrUnexpectedEOF), codes.Unavailable},
+		{IOErrorCoder(), io.ErrShortWrite, codes.Internal},
+		{IOErrorCoderWithEOF(codes.Unknown), io.EOF, codes.Unknown},
+		{IOErrorCoderWithEOF(codes.Unavailable), io.EOF, codes.Unavailable},
+		{IOErrorCoderWithEOF(codes.Unavailable), io.ErrClosedPipe, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		if got := tt.coder.ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestNewDual(t *testing.T) {
+	inner := errors.New("conflict")
+	err := NewDual(codes.Aborted, 409, inner)
+	e, ok := err.(DualError)
+	if !ok {
+		t.Fatalf("NewDual: got %T; want DualError", err)
+	}
+	if e.Code() != codes.Aborted || e.HTTPCode() != 409 || !errors.Is(err, inner) || err.Error() != "conflict" {
+		t.Errorf("NewDual: got (%v, %d, %q)", e.Code(), e.HTTPCode(), err)
+	}
+	if got := CodedErrorCoder().ErrorCode(fmt.Errorf("update: %w", err)); got != codes.Aborted {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.Aborted)
+	}
+}
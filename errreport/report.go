@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package errreport provides the ability to classify errors by their codes
+// before they're sent to an error reporting service, such as Sentry.
+//
+// A Hook resolves the code of each error, maps it to a Severity, and passes
+// the errors that should be reported to a Reporter with a fingerprint that
+// groups them by code. By default, errors caused by clients, such as NotFound
+// or InvalidArgument, aren't reported.
+package errreport
+
+import (
+	"context"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// A Severity is the severity of a reported error.
+type Severity int
+
+// Severities in increasing order.
+const (
+	// Ignore indicates that an error must not be reported.
+	Ignore Severity = iota
+	Info
+	Warning
+	Error
+	Fatal
+)
+
+var severityNames = [...]string{
+	Ignore:  "ignore",
+	Info:    "info",
+	Warning: "warning",
+	Error:   "error",
+	Fatal:   "fatal",
+}
+
+func (s Severity) String() string {
+	if 0 <= s && int(s) < len(severityNames) {
+		return severityNames[s]
+	}
+	return "unknown"
+}
+
+var defaultSeverities = map[codes.Code]Severity{
+	// Client faults aren't reported.
+	codes.Canceled:           Ignore,
+	codes.InvalidArgument:    Ignore,
+	codes.NotFound:           Ignore,
+	codes.AlreadyExists:      Ignore,
+	codes.PermissionDenied:   Ignore,
+	codes.FailedPrecondition: Ignore,
+	codes.OutOfRange:         Ignore,
+	codes.Unauthenticated:    Ignore,
+
+	// Transient failures are expected occasionally.
+	codes.DeadlineExceeded:  Warning,
+	codes.ResourceExhausted: Warning,
+	codes.Aborted:           Warning,
+	codes.Unavailable:       Warning,
+	codes.Unimplemented:     Warning,
+
+	// Server faults are reported.
+	codes.Unknown:  Error,
+	codes.Internal: Error,
+	codes.DataLoss: Fatal,
+}
+
+// DefaultSeverity returns the default severity of the code.
+// OK and unrecognized codes are ignored.
+func DefaultSeverity(code codes.Code) Severity {
+	return defaultSeverities[code]
+}
+
+// An Event describes an error to report.
+type Event struct {
+	// Err is the reported error.
+	Err error
+	// Code is the error's code.
+	Code codes.Code
+	// Severity is the error's severity, which is never Ignore.
+	Severity Severity
+	// Fingerprint identifies the group of similar errors.
+	// By default, it's the canonical name of the code, such as "INTERNAL".
+	Fingerprint []string
+}
+
+// A Reporter sends events to an error reporting service.
+type Reporter interface {
+	Report(ctx context.Context, event *Event)
+}
+
+// A ReporterFunc is a function that implements Reporter.
+type ReporterFunc func(ctx context.Context, event *Event)
+
+// Report calls fn(ctx, event).
+func (fn ReporterFunc) Report(ctx context.Context, event *Event) { fn(ctx, event) }
+
+// An Option configures a Hook.
+type Option func(*Hook)
+
+// WithSeverities returns an Option that overrides the severities of codes.
+// For example, NotFound errors may be reported as warnings, or Unavailable
+// errors may be ignored.
+func WithSeverities(severities map[codes.Code]Severity) Option {
+	return func(h *Hook) {
+		for code, s := range severities {
+			h.severities[code] = s
+		}
+	}
+}
+
+// WithFingerprintFunc returns an Option that sets the function used to build
+// the fingerprint of an event.
+func WithFingerprintFunc(fn func(code codes.Code, err error) []string) Option {
+	return func(h *Hook) { h.fingerprintFn = fn }
+}
+
+// A Hook classifies errors by their codes and reports them.
+type Hook struct {
+	reporter      Reporter
+	coder         errcode.ErrorCoder
+	severities    map[codes.Code]Severity
+	fingerprintFn func(codes.Code, error) []string
+}
+
+// NewHook returns a new Hook that resolves codes with the given ErrorCoder
+// and reports errors to the given Reporter.
+func NewHook(reporter Reporter, coder errcode.ErrorCoder, opts ...Option) *Hook {
+	h := &Hook{
+		reporter:      reporter,
+		coder:         coder,
+		severities:    make(map[codes.Code]Severity, len(defaultSeverities)),
+		fingerprintFn: defaultFingerprint,
+	}
+	for code, s := range defaultSeverities {
+		h.severities[code] = s
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func defaultFingerprint(code codes.Code, _ error) []string {
+	return []string{errcode.CodeString(code)}
+}
+
+// Report reports the error unless it's nil or its severity is Ignore,
+// and reports whether it was reported.
+func (h *Hook) Report(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+	code := errcode.ResolveError(h.coder, err)
+	s := h.severities[code]
+	if s == Ignore {
+		return false
+	}
+	h.reporter.Report(ctx, &Event{
+		Err:         err,
+		Code:        code,
+		Severity:    s,
+		Fingerprint: h.fingerprintFn(code, err),
+	})
+	return true
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHook(t *testing.T) {
+	var events []*Event
+	reporter := ReporterFunc(func(_ context.Context, e *Event) { events = append(events, e) })
+	coder := errcode.Compact(errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder())
+	h := NewHook(reporter, coder, WithSeverities(map[codes.Code]Severity{codes.Unavailable: Ignore}))
+
+	tests := []struct {
+		err      error
+		reported bool
+	}{
+		{nil, false},
+		{fmt.Errorf("open: %w", fs.ErrNotExist), false},
+		{errcode.New(codes.Unavailable, errors.New("down")), false},
+		{errcode.New(codes.DataLoss, errors.New("corrupt")), true},
+		{errors.New("boom"), true},
+	}
+	for _, tt := range tests {
+		if got := h.Report(context.Background(), tt.err); got != tt.reported {
+			t.Errorf("Report(%v): got %v; want %v", tt.err, got, tt.reported)
+		}
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("events: got %d; want 2", len(events))
+	}
+	if e := events[0]; e.Code != codes.DataLoss || e.Severity != Fatal || !slices.Equal(e.Fingerprint, []string{"DATA_LOSS"}) {
+		t.Errorf("event: got %+v", e)
+	}
+	if e := events[1]; e.Code != codes.Unknown || e.Severity != Error || !slices.Equal(e.Fingerprint, []string{"UNKNOWN"}) {
+		t.Errorf("event: got %+v", e)
+	}
+}
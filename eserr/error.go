@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package eserr provides the ability to extract the status code from Elasticsearch
+// and OpenSearch errors.
+//
+// The official clients return unsuccessful responses rather than errors, so they
+// must be converted with FromResponse. It doesn't depend on either client:
+//
+//	res, err := es.Get("index", "id")
+//	if err != nil {
+//		return err
+//	}
+//	defer res.Body.Close()
+//	if err := eserr.FromResponse(res.StatusCode, res.Body); err != nil {
+//		return err
+//	}
+package eserr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Elasticsearch ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://www.elastic.co/docs/api/doc/elasticsearch/
+
+var typeCodes = map[string]codes.Code{
+	"task_cancelled_exception": codes.Canceled,
+
+	"action_request_validation_exception": codes.InvalidArgument,
+	"illegal_argument_exception":          codes.InvalidArgument,
+	"invalid_index_name_exception":        codes.InvalidArgument,
+	"mapper_parsing_exception":            codes.InvalidArgument,
+	"parsing_exception":                   codes.InvalidArgument,
+	"query_shard_exception":               codes.InvalidArgument,
+	"x_content_parse_exception":           codes.InvalidArgument,
+
+	"process_cluster_event_timeout_exception": codes.DeadlineExceeded,
+	"receive_timeout_transport_exception":     codes.DeadlineExceeded,
+	"timeout_exception":                       codes.DeadlineExceeded,
+
+	"document_missing_exception":   codes.NotFound,
+	"index_not_found_exception":    codes.NotFound,
+	"resource_not_found_exception": codes.NotFound,
+
+	"resource_already_exists_exception": codes.AlreadyExists,
+
+	"security_exception": codes.PermissionDenied,
+
+	"circuit_breaking_exception":      codes.ResourceExhausted,
+	"es_rejected_execution_exception": codes.ResourceExhausted,
+
+	"cluster_block_exception": codes.FailedPrecondition,
+
+	"version_conflict_engine_exception": codes.Aborted,
+
+	"master_not_discovered_exception":     codes.Unavailable,
+	"no_shard_available_action_exception": codes.Unavailable,
+	"node_not_connected_exception":        codes.Unavailable,
+	"unavailable_shards_exception":        codes.Unavailable,
+}
+
+// An Error is an unsuccessful Elasticsearch or OpenSearch response.
+type Error struct {
+	Status int    // HTTP status code
+	Type   string // Error type, e.g. "index_not_found_exception"
+	Reason string // Error reason
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Type != "" && e.Reason != "":
+		return fmt.Sprintf("elasticsearch: %s: %s", e.Type, e.Reason)
+	case e.Type != "":
+		return "elasticsearch: " + e.Type
+	case e.Reason != "":
+		return "elasticsearch: " + e.Reason
+	}
+	return fmt.Sprintf("elasticsearch: %d %s", e.Status, http.StatusText(e.Status))
+}
+
+// HTTPCode returns the HTTP status code of the response.
+func (e *Error) HTTPCode() int { return e.Status }
+
+// FromResponse returns an *Error describing the response if the status code
+// isn't successful, or nil otherwise. The body is read, but not closed.
+func FromResponse(status int, body io.Reader) error {
+	if 200 <= status && status <= 299 {
+		return nil
+	}
+	e := &Error{Status: status}
+	if body == nil {
+		return e
+	}
+	var res struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.NewDecoder(body).Decode(&res); err != nil || len(res.Error) == 0 {
+		return e
+	}
+	// Older versions report the error as a plain string.
+	var cause struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(res.Error, &cause); err == nil {
+		e.Type, e.Reason = cause.Type, cause.Reason
+	} else {
+		_ = json.Unmarshal(res.Error, &e.Reason)
+	}
+	return e
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an *Error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*Error](err); ok {
+		return e.code()
+	}
+	return codes.Unknown
+}
+
+func (e *Error) code() codes.Code {
+	if e.Type == "security_exception" && e.Status == http.StatusUnauthorized {
+		return codes.Unauthenticated
+	}
+	if code, ok := typeCodes[e.Type]; ok {
+		return code
+	}
+	return httperr.ToGRPC(e.Status)
+}
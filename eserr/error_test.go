@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package eserr
+
+import (
+	"strings"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestFromResponse(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   codes.Code
+	}{
+		{200, `{"found":true}`, codes.OK},
+		{404, `{"error":{"root_cause":[],"type":"index_not_found_exception","reason":"no such index [x]"},"status":404}`, codes.NotFound},
+		{409, `{"error":{"type":"version_conflict_engine_exception","reason":"version conflict"},"status":409}`, codes.Aborted},
+		{429, `{"error":{"type":"circuit_breaking_exception","reason":"data too large"},"status":429}`, codes.ResourceExhausted},
+		{403, `{"error":{"type":"security_exception","reason":"action is unauthorized"},"status":403}`, codes.PermissionDenied},
+		{401, `{"error":{"type":"security_exception","reason":"missing authentication credentials"},"status":401}`, codes.Unauthenticated},
+		{400, `{"error":"IndexMissingException[[x] missing]","status":400}`, codes.InvalidArgument},
+		{503, `not json`, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		err := FromResponse(tt.status, strings.NewReader(tt.body))
+		if got := ErrorCode(err); got != tt.want {
+			t.Errorf("ErrorCode(FromResponse(%d, %q)): got %v; want %v", tt.status, tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "index not found", Err: &Error{Status: 404, Type: "index_not_found_exception"}, Want: codes.NotFound},
+		{Name: "version conflict", Err: &Error{Status: 409, Type: "version_conflict_engine_exception"}, Want: codes.Aborted},
+		{Name: "unauthenticated", Err: &Error{Status: 401, Type: "security_exception"}, Want: codes.Unauthenticated},
+		{Name: "status", Err: &Error{Status: 503}, Want: codes.Unavailable},
+	})
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package etcderr provides the ability to extract the status code from etcd errors
+// from the go.etcd.io/etcd/client/v3 package.
+//
+// The etcd server reports many errors with generic codes (e.g. a missing key
+// or failed authentication is InvalidArgument), which are refined here.
+package etcderr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the etcd ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var etcdCodes = map[error]codes.Code{
+	rpctypes.ErrKeyNotFound:    codes.NotFound,
+	rpctypes.ErrUserNotFound:   codes.NotFound,
+	rpctypes.ErrRoleNotFound:   codes.NotFound,
+	rpctypes.ErrMemberNotFound: codes.NotFound,
+	rpctypes.ErrLeaseNotFound:  codes.NotFound,
+
+	rpctypes.ErrLeaseExist:        codes.AlreadyExists,
+	rpctypes.ErrMemberExist:       codes.AlreadyExists,
+	rpctypes.ErrPeerURLExist:      codes.AlreadyExists,
+	rpctypes.ErrRoleAlreadyExist:  codes.AlreadyExists,
+	rpctypes.ErrUserAlreadyExist:  codes.AlreadyExists,
+	rpctypes.ErrPermissionDenied:  codes.PermissionDenied,
+	rpctypes.ErrRoleNotGranted:    codes.PermissionDenied,
+	rpctypes.ErrNoSpace:           codes.ResourceExhausted,
+	rpctypes.ErrTooManyRequests:   codes.ResourceExhausted,
+	rpctypes.ErrRequestTooLarge:   codes.ResourceExhausted,
+	rpctypes.ErrAuthFailed:        codes.Unauthenticated,
+	rpctypes.ErrInvalidAuthToken:  codes.Unauthenticated,
+	rpctypes.ErrAuthOldRevision:   codes.Aborted,
+	rpctypes.ErrCompacted:         codes.OutOfRange,
+	rpctypes.ErrFutureRev:         codes.OutOfRange,
+	rpctypes.ErrNoLeader:          codes.Unavailable,
+	rpctypes.ErrNotLeader:         codes.Unavailable,
+	rpctypes.ErrLeaderChanged:     codes.Unavailable,
+	rpctypes.ErrStopped:           codes.Unavailable,
+	rpctypes.ErrUnhealthy:         codes.Unavailable,
+	rpctypes.ErrCorrupt:           codes.DataLoss,
+	rpctypes.ErrClusterIDMismatch: codes.FailedPrecondition,
+
+	rpctypes.ErrTimeout:                    codes.Unavailable,
+	rpctypes.ErrTimeoutDueToLeaderFail:     codes.Unavailable,
+	rpctypes.ErrTimeoutDueToConnectionLost: codes.Unavailable,
+	rpctypes.ErrTimeoutWaitAppliedIndex:    codes.Unavailable,
+}
+
+var clientCodes = map[error]codes.Code{
+	clientv3.ErrNoAvailableEndpoints: codes.Unavailable,
+	clientv3.ErrOldCluster:           codes.FailedPrecondition,
+}
+
+var clientCoder = errcode.MapErrors(clientCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an etcd server error or a known etcd client error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[rpctypes.EtcdError](err); ok {
+		return etcdCode(e)
+	}
+	if e, ok := errcode.As[grpcerr.Error](err); ok {
+		// Status errors that haven't been converted by the client.
+		if e, ok := rpctypes.Error(e).(rpctypes.EtcdError); ok {
+			return etcdCode(e)
+		}
+	}
+	return clientCoder.ErrorCode(err)
+}
+
+func etcdCode(e rpctypes.EtcdError) codes.Code {
+	if code, ok := etcdCodes[e]; ok {
+		return code
+	}
+	return e.Code()
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package etcderr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "key not found", Err: rpctypes.ErrKeyNotFound, Want: codes.NotFound},
+		{Name: "lease not found", Err: rpctypes.ErrLeaseNotFound, Want: codes.NotFound},
+		{Name: "no space", Err: rpctypes.ErrNoSpace, Want: codes.ResourceExhausted},
+		{Name: "too many requests", Err: rpctypes.ErrTooManyRequests, Want: codes.ResourceExhausted},
+		{Name: "permission denied", Err: rpctypes.ErrPermissionDenied, Want: codes.PermissionDenied},
+		{Name: "auth failed", Err: rpctypes.ErrAuthFailed, Want: codes.Unauthenticated},
+		{Name: "no leader", Err: rpctypes.ErrNoLeader, Want: codes.Unavailable},
+		{Name: "leader changed", Err: rpctypes.ErrLeaderChanged, Want: codes.Unavailable},
+		{Name: "status", Err: rpctypes.ErrGRPCKeyNotFound, Want: codes.NotFound},
+		{Name: "status auth failed", Err: rpctypes.ErrGRPCAuthFailed, Want: codes.Unauthenticated},
+		{Name: "no endpoints", Err: clientv3.ErrNoAvailableEndpoints, Want: codes.Unavailable},
+	})
+}
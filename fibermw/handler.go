@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package fibermw provides a github.com/gofiber/fiber/v2 error handler that
+// writes responses with statuses derived from the codes of errors.
+package fibermw
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"bursavich.dev/errcode/httpmw"
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Fiber ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *fiber.Error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*fiber.Error](err); ok {
+		return httperr.ToGRPC(e.Code)
+	}
+	return codes.Unknown
+}
+
+// An Option configures the error handler.
+type Option func(*options)
+
+type options struct {
+	messageFn func(codes.Code, error) string
+	renderFn  func(*fiber.Ctx, int, *httpmw.ErrorBody) error
+}
+
+// WithMessageFunc returns an Option that sets the function used to build the
+// message of an error response, like httpmw.WithMessageFunc.
+func WithMessageFunc(fn func(code codes.Code, err error) string) Option {
+	return func(o *options) { o.messageFn = fn }
+}
+
+// WithRenderFunc returns an Option that sets the function used to write an
+// error response with the given status and body. By default, the body is
+// written as JSON.
+func WithRenderFunc(fn func(c *fiber.Ctx, status int, body *httpmw.ErrorBody) error) Option {
+	return func(o *options) { o.renderFn = fn }
+}
+
+// ErrorHandler returns a fiber.ErrorHandler that writes an error response with
+// the status derived from the code resolved by the given ErrorCoder. The status
+// of a *fiber.Error is kept if it's consistent with the resolved code, and its
+// message is used as the response's message if it isn't wrapped.
+//
+// It is installed with the ErrorHandler field of fiber.Config.
+func ErrorHandler(coder errcode.ErrorCoder, opts ...Option) fiber.ErrorHandler {
+	o := &options{
+		renderFn: func(c *fiber.Ctx, status int, body *httpmw.ErrorBody) error {
+			return c.Status(status).JSON(body)
+		},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	coder = errcode.Compact(errcode.CodedErrorCoder(), coder, errorCoder)
+	return func(c *fiber.Ctx, err error) error {
+		code := errcode.ResolveError(coder, err)
+		body := httpmw.NewErrorBody(code, err, o.messageFn)
+		reported := 0
+		if e, ok := errcode.As[*fiber.Error](err); ok {
+			reported = e.Code
+			if e == err {
+				body.Message = e.Message
+			}
+		}
+		return o.renderFn(c, httpmw.Status(code, err, reported), body)
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fibermw
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httpmw"
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{fiber.ErrNotFound, codes.NotFound},
+		{fmt.Errorf("parse: %w", fiber.NewError(fiber.StatusBadRequest, "bad")), codes.InvalidArgument},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		opts   []Option
+		status int
+		body   httpmw.ErrorBody
+	}{
+		{
+			name:   "coded",
+			err:    fmt.Errorf("open: %w", fs.ErrNotExist),
+			status: http.StatusNotFound,
+			body:   httpmw.ErrorBody{Code: "NOT_FOUND", Message: "open: file does not exist"},
+		},
+		{
+			name:   "http status",
+			err:    fiber.ErrMethodNotAllowed,
+			status: http.StatusMethodNotAllowed,
+			body:   httpmw.ErrorBody{Code: "UNKNOWN", Message: "Method Not Allowed"},
+		},
+		{
+			name:   "dual",
+			err:    errcode.NewDual(codes.Unavailable, http.StatusTooManyRequests, errors.New("busy")),
+			status: http.StatusTooManyRequests,
+			body:   httpmw.ErrorBody{Code: "UNAVAILABLE", Message: "service unavailable"},
+		},
+		{
+			name:   "public",
+			err:    errcode.WithPublicMessage(errcode.New(codes.Internal, errors.New("db: secret")), "try again"),
+			opts:   []Option{WithMessageFunc(func(codes.Code, error) string { return "redacted" })},
+			status: http.StatusInternalServerError,
+			body:   httpmw.ErrorBody{Code: "INTERNAL", Message: "try again"},
+		},
+		{
+			name:   "message func",
+			err:    errcode.New(codes.Internal, errors.New("db: secret")),
+			opts:   []Option{WithMessageFunc(func(codes.Code, error) string { return "redacted" })},
+			status: http.StatusInternalServerError,
+			body:   httpmw.ErrorBody{Code: "INTERNAL", Message: "redacted"},
+		},
+		{
+			name:   "reason",
+			err:    errcode.WithRequestID(errcode.WithReason(errcode.New(codes.ResourceExhausted, errors.New("slow down")), "example.com", "RATE_LIMITED"), "req-1"),
+			status: http.StatusTooManyRequests,
+			body: httpmw.ErrorBody{
+				Code:      "RESOURCE_EXHAUSTED",
+				Message:   "slow down",
+				Reason:    "RATE_LIMITED",
+				Domain:    "example.com",
+				RequestID: "req-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{
+				ErrorHandler: ErrorHandler(errcode.FileSystemErrorCoder(), tt.opts...),
+			})
+			app.Get("/", func(*fiber.Ctx) error { return tt.err })
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Errorf("status: got %d; want %d", resp.StatusCode, tt.status)
+			}
+			var body httpmw.ErrorBody
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("invalid body: %v", err)
+			}
+			if body != tt.body {
+				t.Errorf("body: got %+v; want %+v", body, tt.body)
+			}
+		})
+	}
+}
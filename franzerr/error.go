@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package franzerr provides the ability to extract the status code from Kafka errors
+// from the github.com/twmb/franz-go packages.
+package franzerr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/kafkaerr"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the franz-go ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	kgo.ErrRecordTimeout: codes.DeadlineExceeded,
+	kgo.ErrRecordRetries: codes.Unavailable,
+	kgo.ErrMaxBuffered:   codes.ResourceExhausted,
+	kgo.ErrAborting:      codes.Aborted,
+	kgo.ErrClientClosed:  codes.FailedPrecondition,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *kerr.Error or a known kgo client error.
+//
+// Unrecognized Kafka errors that are retriable are mapped to Unavailable.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*kerr.Error](err); ok {
+		if code := kafkaerr.Code(e.Code); code != codes.Unknown {
+			return code
+		}
+		if e.Retriable {
+			return codes.Unavailable
+		}
+	}
+	if code := sentinelCoder.ErrorCode(err); code != codes.Unknown {
+		return code
+	}
+	if _, ok := errcode.As[*kgo.ErrFirstReadEOF](err); ok {
+		// The broker closed the connection due to misconfigured TLS or SASL.
+		return codes.FailedPrecondition
+	}
+	if _, ok := errcode.As[*kgo.ErrDataLoss](err); ok {
+		return codes.DataLoss
+	}
+	return codes.Unknown
+}
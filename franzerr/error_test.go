@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package franzerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "unknown topic", Err: kerr.UnknownTopicOrPartition, Want: codes.NotFound},
+		{Name: "rebalance", Err: kerr.RebalanceInProgress, Want: codes.Aborted},
+		{Name: "topic authorization", Err: kerr.TopicAuthorizationFailed, Want: codes.PermissionDenied},
+		{Name: "retriable", Err: &kerr.Error{Message: "FUTURE_ERROR", Code: 9999, Retriable: true}, Want: codes.Unavailable},
+		{Name: "not retriable", Err: &kerr.Error{Message: "FUTURE_ERROR", Code: 9999}, Want: codes.Unknown},
+		{Name: "record timeout", Err: kgo.ErrRecordTimeout, Want: codes.DeadlineExceeded},
+		{Name: "max buffered", Err: kgo.ErrMaxBuffered, Want: codes.ResourceExhausted},
+		{Name: "client closed", Err: kgo.ErrClientClosed, Want: codes.FailedPrecondition},
+		{Name: "data loss", Err: &kgo.ErrDataLoss{Topic: "events", Partition: 1, ConsumedTo: 10, ResetTo: 5}, Want: codes.DataLoss},
+	})
+}
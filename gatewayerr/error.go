@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gatewayerr provides the ability to extract the status code from gRPC-Gateway errors
+// from the github.com/grpc-ecosystem/grpc-gateway/v2/runtime package and to write
+// consistent error responses from gateway handlers.
+package gatewayerr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcatalog"
+	"bursavich.dev/errcode/grpcerr"
+	"bursavich.dev/errcode/httperr"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the gRPC-Gateway ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *runtime.HTTPStatusError.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*runtime.HTTPStatusError](err); ok {
+		return httperr.ToGRPC(e.HTTPStatus)
+	}
+	return codes.Unknown
+}
+
+// An Option configures an error handler.
+type Option func(*options)
+
+type options struct {
+	problem bool
+	catalog *errcatalog.Catalog
+}
+
+// WithProblemDetails returns an Option that writes error responses as
+// RFC 7807 problem details with the "application/problem+json" content type,
+// instead of as a google.rpc.Status encoded by the gateway's marshaler.
+// The gRPC code is included in the "code" member by its canonical name.
+// The reason and domain of an ErrorInfo detail are included in the "reason"
+// and "domain" members, and the request ID of a RequestInfo detail is included
+// in the "request_id" member.
+func WithProblemDetails() Option {
+	return func(o *options) { o.problem = true }
+}
+
+// WithCatalog returns an Option that sets the catalog of localized messages.
+// The message of an error response is rendered by the catalog in the languages
+// of the request's Accept-Language header, if it has one for the error.
+// The status's code and details are preserved.
+func WithCatalog(c *errcatalog.Catalog) Option {
+	return func(o *options) { o.catalog = c }
+}
+
+// ErrorHandler returns a runtime.ErrorHandlerFunc that resolves the code of
+// errors without a gRPC status using the given ErrorCoder. Errors that already
+// have a gRPC status keep it, and the HTTP status of a *runtime.HTTPStatusError
+// is preserved. Otherwise, the HTTP status is derived from the gRPC code.
+// An error's public message, if it has one, replaces the status's message.
+// Details describing an error's reason and request ID are added to the status,
+// as by grpcerr.WithErrorDetails.
+//
+// It is installed with runtime.WithErrorHandler.
+func ErrorHandler(coder errcode.ErrorCoder, opts ...Option) runtime.ErrorHandlerFunc {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	coder = errcode.Compact(grpcerr.ErrorCoder(), coder)
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		httpStatus := 0
+		if e, ok := errcode.As[*runtime.HTTPStatusError](err); ok {
+			httpStatus, err = e.HTTPStatus, e.Err
+		}
+		s := toStatus(coder, err)
+		if o.catalog != nil {
+			if msg, ok := o.catalog.Render(grpcerr.New(s, err), r.Header.Get("Accept-Language")); ok {
+				p := s.Proto()
+				p.Message = msg
+				s = status.FromProto(p)
+			}
+		}
+		if o.problem {
+			if httpStatus == 0 {
+				httpStatus = runtime.HTTPStatusFromCode(s.Code())
+			}
+			writeProblem(w, httpStatus, s)
+			return
+		}
+		err = s.Err()
+		if httpStatus != 0 {
+			err = &runtime.HTTPStatusError{HTTPStatus: httpStatus, Err: err}
+		}
+		runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+	}
+}
+
+func toStatus(coder errcode.ErrorCoder, err error) *status.Status {
+	var s *status.Status
+	if e, ok := errcode.As[grpcerr.Error](err); ok {
+		s = e.GRPCStatus()
+	} else {
+		code := errcode.ResolveError(coder, err)
+		s = status.New(code, err.Error())
+	}
+	if msg, ok := errcode.PublicMessage(err); ok {
+		p := s.Proto()
+		p.Message = msg
+		s = status.FromProto(p)
+	}
+	return grpcerr.WithErrorDetails(s, err)
+}
+
+type problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Code      string `json:"code"`
+	Reason    string `json:"reason,omitempty"`
+	Domain    string `json:"domain,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func writeProblem(w http.ResponseWriter, httpStatus int, s *status.Status) {
+	info := grpcerr.ErrorInfo(s.Err())
+	b, _ := json.Marshal(&problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(httpStatus),
+		Status:    httpStatus,
+		Detail:    s.Message(),
+		Code:      errcode.CodeString(s.Code()),
+		Reason:    info.GetReason(),
+		Domain:    info.GetDomain(),
+		RequestID: grpcerr.RequestInfo(s.Err()).GetRequestId(),
+	})
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(b)
+}
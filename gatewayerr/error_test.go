@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gatewayerr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcatalog"
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{&runtime.HTTPStatusError{HTTPStatus: http.StatusNotFound, Err: errors.New("missing")}, codes.NotFound},
+		{fmt.Errorf("route: %w", &runtime.HTTPStatusError{HTTPStatus: http.StatusConflict, Err: errors.New("conflict")}), codes.Aborted},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: &runtime.HTTPStatusError{HTTPStatus: http.StatusNotFound, Err: errors.New("missing")}, Want: codes.NotFound},
+		{Name: "conflict", Err: &runtime.HTTPStatusError{HTTPStatus: http.StatusConflict, Err: errors.New("conflict")}, Want: codes.Aborted},
+	})
+}
+
+type statusBody struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		body   statusBody
+	}{
+		{
+			name:   "coded",
+			err:    fmt.Errorf("open: %w", fs.ErrNotExist),
+			status: http.StatusNotFound,
+			body:   statusBody{codes.NotFound, "open: file does not exist"},
+		},
+		{
+			name:   "status",
+			err:    status.Error(codes.Aborted, "conflict"),
+			status: http.StatusConflict,
+			body:   statusBody{codes.Aborted, "conflict"},
+		},
+		{
+			name:   "wrapped status",
+			err:    fmt.Errorf("update: %w", status.Error(codes.Aborted, "conflict")),
+			status: http.StatusConflict,
+			body:   statusBody{codes.Aborted, "conflict"},
+		},
+		{
+			name:   "http status",
+			err:    &runtime.HTTPStatusError{HTTPStatus: http.StatusMethodNotAllowed, Err: status.Error(codes.Unimplemented, "method not allowed")},
+			status: http.StatusMethodNotAllowed,
+			body:   statusBody{codes.Unimplemented, "method not allowed"},
+		},
+		{
+			name:   "public",
+			err:    errcode.WithPublicMessage(errcode.New(codes.Internal, errors.New("db: secret")), "try again"),
+			status: http.StatusInternalServerError,
+			body:   statusBody{codes.Internal, "try again"},
+		},
+		{
+			name:   "unknown",
+			err:    errors.New("boom"),
+			status: http.StatusInternalServerError,
+			body:   statusBody{codes.Unknown, "boom"},
+		},
+	}
+	handler := ErrorHandler(errcode.Compact(errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder()))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			handler(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, w, r, tt.err)
+			if w.Code != tt.status {
+				t.Errorf("status: got %d; want %d", w.Code, tt.status)
+			}
+			var body statusBody
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid body %q: %v", w.Body, err)
+			}
+			if body != tt.body {
+				t.Errorf("body: got %+v; want %+v", body, tt.body)
+			}
+		})
+	}
+}
+
+func TestProblemDetails(t *testing.T) {
+	c := errcatalog.New(errcode.FileSystemErrorCoder(), "en")
+	if err := c.Add("fr", codes.NotFound, "", "Introuvable."); err != nil {
+		t.Fatal(err)
+	}
+	handler := ErrorHandler(errcode.Compact(errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder()), WithProblemDetails(), WithCatalog(c))
+	tests := []struct {
+		name   string
+		err    error
+		lang   string
+		status int
+		want   problem
+	}{
+		{
+			name:   "localized",
+			err:    errcode.WithRequestID(fmt.Errorf("open: %w", fs.ErrNotExist), "req-1"),
+			lang:   "fr",
+			status: http.StatusNotFound,
+			want: problem{
+				Type:      "about:blank",
+				Title:     "Not Found",
+				Status:    http.StatusNotFound,
+				Detail:    "Introuvable.",
+				Code:      "NOT_FOUND",
+				RequestID: "req-1",
+			},
+		},
+		{
+			name:   "http status",
+			err:    &runtime.HTTPStatusError{HTTPStatus: http.StatusMethodNotAllowed, Err: status.Error(codes.Unimplemented, "method not allowed")},
+			status: http.StatusMethodNotAllowed,
+			want: problem{
+				Type:   "about:blank",
+				Title:  "Method Not Allowed",
+				Status: http.StatusMethodNotAllowed,
+				Detail: "method not allowed",
+				Code:   "UNIMPLEMENTED",
+			},
+		},
+		{
+			name:   "reason",
+			err:    errcode.WithReason(errcode.New(codes.ResourceExhausted, errors.New("slow down")), "example.com", "RATE_LIMITED"),
+			status: http.StatusTooManyRequests,
+			want: problem{
+				Type:   "about:blank",
+				Title:  "Too Many Requests",
+				Status: http.StatusTooManyRequests,
+				Detail: "slow down",
+				Code:   "RESOURCE_EXHAUSTED",
+				Reason: "RATE_LIMITED",
+				Domain: "example.com",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Language", tt.lang)
+			handler(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, w, r, tt.err)
+			if w.Code != tt.status {
+				t.Errorf("status: got %d; want %d", w.Code, tt.status)
+			}
+			if got := w.Header().Get("Content-Type"); got != "application/problem+json" {
+				t.Errorf("content type: got %q", got)
+			}
+			var got problem
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("invalid body %q: %v", w.Body, err)
+			}
+			if got != tt.want {
+				t.Errorf("problem: got %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
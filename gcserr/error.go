@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gcserr provides the ability to extract the status code from Google Cloud Storage errors
+// from the cloud.google.com/go/storage package.
+//
+// The storage client reports missing objects and buckets with sentinel errors
+// rather than API errors, so it should be used before the googleapierr ErrorCoder.
+package gcserr
+
+import (
+	"net/http"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Google Cloud Storage ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	storage.ErrObjectNotExist: codes.NotFound,
+	storage.ErrBucketNotExist: codes.NotFound,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known storage sentinel error.
+func ErrorCode(err error) codes.Code {
+	return sentinelCoder.ErrorCode(err)
+}
+
+// Retryable reports whether the error is a transient failure of the
+// JSON API (429 or 5xx) or the gRPC API (ResourceExhausted, Internal,
+// or Unavailable) that may be retried.
+func Retryable(err error) bool {
+	if e, ok := errcode.As[*googleapi.Error](err); ok {
+		return e.Code == http.StatusTooManyRequests || 500 <= e.Code && e.Code <= 599
+	}
+	switch grpcerr.ErrorCode(err) {
+	case codes.ResourceExhausted, codes.Internal, codes.Unavailable:
+		return true
+	}
+	return false
+}
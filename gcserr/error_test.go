@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gcserr
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "object not exist", Err: storage.ErrObjectNotExist, Want: codes.NotFound},
+		{Name: "bucket not exist", Err: storage.ErrBucketNotExist, Want: codes.NotFound},
+	})
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{&googleapi.Error{Code: http.StatusTooManyRequests}, true},
+		{&googleapi.Error{Code: http.StatusServiceUnavailable}, true},
+		{&googleapi.Error{Code: http.StatusNotFound}, false},
+		{status.Error(codes.Unavailable, "try again"), true},
+		{status.Error(codes.PermissionDenied, "denied"), false},
+		{storage.ErrObjectNotExist, false},
+		{errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := Retryable(tt.err); got != tt.want {
+			t.Errorf("Retryable(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package ginmw provides github.com/gin-gonic/gin middleware that writes
+// responses with statuses derived from the codes of errors.
+package ginmw
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"bursavich.dev/errcode/httpmw"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+// An Option configures the middleware.
+type Option func(*options)
+
+type options struct {
+	messageFn func(codes.Code, error) string
+}
+
+// WithMessageFunc returns an Option that sets the function used to build the
+// message of an error response, like httpmw.WithMessageFunc.
+func WithMessageFunc(fn func(code codes.Code, err error) string) Option {
+	return func(o *options) { o.messageFn = fn }
+}
+
+// Middleware returns middleware that writes an error response for the last
+// error in c.Errors after the handlers have run, unless a response has already
+// been written. The response's status is derived from the code resolved by
+// the given ErrorCoder, and its body is an httpmw.ErrorBody. Bind errors
+// are InvalidArgument if the ErrorCoder doesn't recognize them.
+//
+// The ErrorCoder is installed in the request's context and may be retrieved
+// with errcode.FromContext.
+func Middleware(coder errcode.ErrorCoder, opts ...Option) gin.HandlerFunc {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	coder = errcode.Compact(errcode.CodedErrorCoder(), coder)
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(errcode.NewContext(c.Request.Context(), coder))
+		c.Next()
+
+		e := c.Errors.Last()
+		if e == nil || c.Writer.Written() {
+			return
+		}
+		code := errcode.ResolveError(coder, e.Err)
+		if code == codes.Unknown && e.IsType(gin.ErrorTypeBind) {
+			code = codes.InvalidArgument
+		}
+		body := httpmw.NewErrorBody(code, e.Err, o.messageFn)
+		c.JSON(httperr.Status(code, e.Err), body)
+	}
+}
+
+// Abort prevents pending handlers from being called and attaches
+// the error with the given code to the context for Middleware to write.
+func Abort(c *gin.Context, code codes.Code, err error) {
+	c.Abort()
+	_ = c.Error(errcode.New(code, err))
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package ginmw
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httpmw"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestMiddleware(t *testing.T) {
+	coder := errcode.FileSystemErrorCoder()
+	tests := []struct {
+		name   string
+		fn     gin.HandlerFunc
+		opts   []Option
+		status int
+		body   *httpmw.ErrorBody
+	}{
+		{
+			name: "ok",
+			fn: func(c *gin.Context) {
+				if _, ok := errcode.FromContext(c.Request.Context()); !ok {
+					t.Error("FromContext: missing ErrorCoder")
+				}
+				c.Status(http.StatusNoContent)
+			},
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "coded",
+			fn:     func(c *gin.Context) { _ = c.Error(fmt.Errorf("open: %w", fs.ErrNotExist)) },
+			status: http.StatusNotFound,
+			body:   &httpmw.ErrorBody{Code: "NOT_FOUND", Message: "open: file does not exist"},
+		},
+		{
+			name:   "abort",
+			fn:     func(c *gin.Context) { Abort(c, codes.FailedPrecondition, errors.New("not ready")) },
+			status: http.StatusBadRequest,
+			body:   &httpmw.ErrorBody{Code: "FAILED_PRECONDITION", Message: "not ready"},
+		},
+		{
+			name:   "bind",
+			fn:     func(c *gin.Context) { _ = c.Error(errors.New("invalid json")).SetType(gin.ErrorTypeBind) },
+			status: http.StatusBadRequest,
+			body:   &httpmw.ErrorBody{Code: "INVALID_ARGUMENT", Message: "invalid json"},
+		},
+		{
+			name: "dual",
+			fn: func(c *gin.Context) {
+				_ = c.Error(errcode.NewDual(codes.Unavailable, http.StatusTooManyRequests, errors.New("busy")))
+			},
+			status: http.StatusTooManyRequests,
+			body:   &httpmw.ErrorBody{Code: "UNAVAILABLE", Message: "service unavailable"},
+		},
+		{
+			name: "public",
+			fn: func(c *gin.Context) {
+				_ = c.Error(errcode.WithPublicMessage(errcode.New(codes.Internal, errors.New("db: secret")), "try again"))
+			},
+			opts:   []Option{WithMessageFunc(func(codes.Code, error) string { return "redacted" })},
+			status: http.StatusInternalServerError,
+			body:   &httpmw.ErrorBody{Code: "INTERNAL", Message: "try again"},
+		},
+		{
+			name:   "message func",
+			fn:     func(c *gin.Context) { Abort(c, codes.Internal, errors.New("db: secret")) },
+			opts:   []Option{WithMessageFunc(func(codes.Code, error) string { return "redacted" })},
+			status: http.StatusInternalServerError,
+			body:   &httpmw.ErrorBody{Code: "INTERNAL", Message: "redacted"},
+		},
+		{
+			name: "reason",
+			fn: func(c *gin.Context) {
+				err := errcode.WithReason(errcode.New(codes.ResourceExhausted, errors.New("slow down")), "example.com", "RATE_LIMITED")
+				_ = c.Error(errcode.WithRequestID(err, "req-1"))
+			},
+			status: http.StatusTooManyRequests,
+			body: &httpmw.ErrorBody{
+				Code:      "RESOURCE_EXHAUSTED",
+				Message:   "slow down",
+				Reason:    "RATE_LIMITED",
+				Domain:    "example.com",
+				RequestID: "req-1",
+			},
+		},
+		{
+			name: "written",
+			fn: func(c *gin.Context) {
+				c.String(http.StatusAccepted, "accepted")
+				_ = c.Error(fs.ErrNotExist)
+			},
+			status: http.StatusAccepted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(Middleware(coder, tt.opts...))
+			r.GET("/", tt.fn)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			if w.Code != tt.status {
+				t.Errorf("status: got %d; want %d", w.Code, tt.status)
+			}
+			if tt.body == nil {
+				return
+			}
+			var body httpmw.ErrorBody
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid body %q: %v", w.Body, err)
+			}
+			if body != *tt.body {
+				t.Errorf("body: got %+v; want %+v", body, *tt.body)
+			}
+		})
+	}
+}
@@ -2,10 +2,14 @@ module bursavich.dev/errcode
 
 go 1.24.0
 
-require google.golang.org/grpc v1.72.2
+require (
+	golang.org/x/net v0.35.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a
+	google.golang.org/grpc v1.72.2
+	google.golang.org/protobuf v1.36.6
+)
 
 require (
 	golang.org/x/sys v0.33.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
-	google.golang.org/protobuf v1.36.6 // indirect
+	golang.org/x/text v0.22.0 // indirect
 )
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gobreakererr provides the ability to classify the errors counted by
+// circuit breakers from the github.com/sony/gobreaker/v2 package by their codes.
+package gobreakererr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/grpc/codes"
+)
+
+// Settings returns a copy of the settings whose IsSuccessful and IsExcluded
+// functions classify errors by the codes resolved by the coders, unless they're
+// already set. Errors count as failures only if their codes are ServerErrors or
+// Transient, as reported by errcode.BreakerSuccess, and Canceled errors are
+// excluded, because they say nothing about the health of the dependency.
+func Settings(st gobreaker.Settings, coders ...errcode.ErrorCoder) gobreaker.Settings {
+	coder := errcode.Compact(coders...)
+	if st.IsSuccessful == nil {
+		st.IsSuccessful = errcode.BreakerSuccess(coder)
+	}
+	if st.IsExcluded == nil {
+		st.IsExcluded = func(err error) bool {
+			return err != nil && coder.ErrorCode(err) == codes.Canceled
+		}
+	}
+	return st
+}
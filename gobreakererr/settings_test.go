@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gobreakererr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSettings(t *testing.T) {
+	cb := gobreaker.NewCircuitBreaker[any](Settings(gobreaker.Settings{
+		ReadyToTrip: func(c gobreaker.Counts) bool { return c.ConsecutiveFailures >= 2 },
+	}, errcode.CodedErrorCoder(), errcode.ContextErrorCoder()))
+
+	fail := func(err error) {
+		_, _ = cb.Execute(func() (any, error) { return nil, err })
+	}
+	for range 3 {
+		fail(errcode.New(codes.NotFound, errors.New("missing")))
+		fail(fmt.Errorf("call: %w", context.Canceled))
+	}
+	if got := cb.State(); got != gobreaker.StateClosed {
+		t.Fatalf("client faults: got state %v; want %v", got, gobreaker.StateClosed)
+	}
+	if got := cb.Counts(); got.TotalFailures != 0 || got.TotalSuccesses != 3 {
+		t.Errorf("counts: got %+v; want 3 successes", got)
+	}
+	for range 2 {
+		fail(errcode.New(codes.Unavailable, errors.New("down")))
+	}
+	if got := cb.State(); got != gobreaker.StateOpen {
+		t.Errorf("server faults: got state %v; want %v", got, gobreaker.StateOpen)
+	}
+}
+
+func ExampleSettings() {
+	cb := gobreaker.NewCircuitBreaker[string](Settings(gobreaker.Settings{
+		Name: "inventory",
+	}, errcode.CodedErrorCoder()))
+
+	// A NotFound error is the caller's fault, so it counts as a success.
+	_, err := cb.Execute(func() (string, error) {
+		return "", errcode.New(codes.NotFound, errors.New("item not found"))
+	})
+	fmt.Println(err, cb.Counts().TotalSuccesses)
+	// Output: item not found 1
+}
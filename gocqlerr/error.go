@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gocqlerr provides the ability to extract the status code from Cassandra errors
+// from the github.com/gocql/gocql package.
+package gocqlerr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Cassandra ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://github.com/apache/cassandra/blob/trunk/doc/native_protocol_v5.spec
+
+var requestCodes = map[int]codes.Code{
+	gocql.ErrCodeServer:          codes.Internal,           // Server error
+	gocql.ErrCodeProtocol:        codes.Internal,           // Protocol error
+	gocql.ErrCodeCredentials:     codes.Unauthenticated,    // Authentication error
+	gocql.ErrCodeUnavailable:     codes.Unavailable,        // Unavailable exception
+	gocql.ErrCodeOverloaded:      codes.ResourceExhausted,  // Overloaded
+	gocql.ErrCodeBootstrapping:   codes.Unavailable,        // Is_bootstrapping
+	gocql.ErrCodeTruncate:        codes.Internal,           // Truncate_error
+	gocql.ErrCodeWriteTimeout:    codes.DeadlineExceeded,   // Write_timeout
+	gocql.ErrCodeReadTimeout:     codes.DeadlineExceeded,   // Read_timeout
+	gocql.ErrCodeReadFailure:     codes.Unavailable,        // Read_failure
+	gocql.ErrCodeFunctionFailure: codes.InvalidArgument,    // Function_failure
+	gocql.ErrCodeWriteFailure:    codes.Unavailable,        // Write_failure
+	gocql.ErrCodeCDCWriteFailure: codes.Unavailable,        // CDC_write_failure
+	gocql.ErrCodeCASWriteUnknown: codes.Unavailable,        // CAS_write_unknown
+	gocql.ErrCodeSyntax:          codes.InvalidArgument,    // Syntax_error
+	gocql.ErrCodeUnauthorized:    codes.PermissionDenied,   // Unauthorized
+	gocql.ErrCodeInvalid:         codes.InvalidArgument,    // Invalid
+	gocql.ErrCodeConfig:          codes.FailedPrecondition, // Config_error
+	gocql.ErrCodeAlreadyExists:   codes.AlreadyExists,      // Already_exists
+	gocql.ErrCodeUnprepared:      codes.FailedPrecondition, // Unprepared
+}
+
+var errorCodes = map[error]codes.Code{
+	gocql.ErrNotFound:             codes.NotFound,
+	gocql.ErrKeyspaceDoesNotExist: codes.NotFound,
+	gocql.ErrTimeoutNoResponse:    codes.DeadlineExceeded,
+	gocql.ErrTooManyTimeouts:      codes.Unavailable,
+	gocql.ErrConnectionClosed:     codes.Unavailable,
+	gocql.ErrNoConnections:        codes.Unavailable,
+	gocql.ErrNoStreams:            codes.Unavailable,
+	gocql.ErrUnavailable:          codes.Unavailable,
+	gocql.ErrSessionClosed:        codes.FailedPrecondition,
+	gocql.ErrNoKeyspace:           codes.InvalidArgument,
+	gocql.ErrQueryArgLength:       codes.InvalidArgument,
+	gocql.ErrTooManyStmts:         codes.InvalidArgument,
+	gocql.ErrUseStmt:              codes.InvalidArgument,
+	gocql.ErrFrameTooBig:          codes.ResourceExhausted,
+	gocql.ErrUnsupported:          codes.Unimplemented,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a gocql.RequestError or a known gocql sentinel error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[gocql.RequestError](err); ok {
+		if code, ok := requestCodes[e.Code()]; ok {
+			return code
+		}
+	}
+	return sentinelCoder.ErrorCode(err)
+}
+
+// RequestCode returns the gRPC code associated with the given
+// Cassandra native protocol error code.
+func RequestCode(code int) codes.Code {
+	if c, ok := requestCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gocqlerr
+
+import (
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+)
+
+// requestError is a gocql.RequestError, like those of the driver's error frames.
+type requestError int
+
+func (e requestError) Code() int       { return int(e) }
+func (e requestError) Message() string { return fmt.Sprintf("error %#x", int(e)) }
+func (e requestError) Error() string   { return e.Message() }
+
+var _ gocql.RequestError = requestError(0)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "unauthorized", Err: requestError(gocql.ErrCodeUnauthorized), Want: codes.PermissionDenied},
+		{Name: "read timeout", Err: requestError(gocql.ErrCodeReadTimeout), Want: codes.DeadlineExceeded},
+		{Name: "write timeout", Err: requestError(gocql.ErrCodeWriteTimeout), Want: codes.DeadlineExceeded},
+		{Name: "unavailable", Err: requestError(gocql.ErrCodeUnavailable), Want: codes.Unavailable},
+		{Name: "already exists", Err: requestError(gocql.ErrCodeAlreadyExists), Want: codes.AlreadyExists},
+		{Name: "syntax", Err: requestError(gocql.ErrCodeSyntax), Want: codes.InvalidArgument},
+		{Name: "invalid", Err: requestError(gocql.ErrCodeInvalid), Want: codes.InvalidArgument},
+		{Name: "overloaded", Err: requestError(gocql.ErrCodeOverloaded), Want: codes.ResourceExhausted},
+		{Name: "unmapped", Err: requestError(0x7fff), Want: codes.Unknown},
+		{Name: "not found", Err: gocql.ErrNotFound, Want: codes.NotFound},
+		{Name: "no connections", Err: gocql.ErrNoConnections, Want: codes.Unavailable},
+		{Name: "session closed", Err: gocql.ErrSessionClosed, Want: codes.FailedPrecondition},
+	})
+}
+
+func TestRequestCode(t *testing.T) {
+	if got := RequestCode(gocql.ErrCodeOverloaded); got != codes.ResourceExhausted {
+		t.Errorf("RequestCode(Overloaded): got %v; want %v", got, codes.ResourceExhausted)
+	}
+	if got := RequestCode(0x7fff); got != codes.Unknown {
+		t.Errorf("RequestCode(0x7fff): got %v; want %v", got, codes.Unknown)
+	}
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !plan9
+
+package googleapierr
+
+import (
+	"syscall"
+
+	"bursavich.dev/errcode"
+)
+
+func isConnRefused(err error) bool { return errcode.Is(err, syscall.ECONNREFUSED) }
+
+func isConnReset(err error) bool { return errcode.Is(err, syscall.ECONNRESET) }
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package googleapierr
+
+func isConnRefused(error) bool { return false }
+
+func isConnReset(error) bool { return false }
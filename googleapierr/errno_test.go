@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !plan9
+
+package googleapierr
+
+import (
+	"net"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestRetryableErrno(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	if !Retryable(refused) {
+		t.Error("Retryable(connection refused) = false; want true")
+	}
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	if Retryable(reset) {
+		t.Error("Retryable(connection reset) = true; want false")
+	}
+	if !RetryableIdempotent(reset) {
+		t.Error("RetryableIdempotent(connection reset) = false; want true")
+	}
+}
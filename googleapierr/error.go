@@ -8,16 +8,18 @@
 package googleapierr
 
 import (
-	"errors"
-
 	"bursavich.dev/errcode"
 	"bursavich.dev/errcode/grpcerr"
 	"bursavich.dev/errcode/httperr"
+	"github.com/googleapis/gax-go/v2/apierror"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/grpc/codes"
 )
 
 var errorCoder errcode.ErrorCoder = errcode.ErrorCoders{
+	// NOTE: Some reasons are more specific than the status that accompanies them,
+	// such as a rate limit that is reported with an HTTP 403 Forbidden status.
+	errcode.FromFunc(reasonErrorCode),
 	// NOTE: github.com/googleapis/gax-go/v2/apierror.APIError implements gRPC and HTTP error interfaces.
 	// These are prefered over *google.golang.org/api/googleapi.Error which only include an HTTP code.
 	grpcerr.ErrorCoder(),
@@ -37,8 +39,96 @@ func googleAPIErrorCode(err error) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	if ge, ok := err.(*googleapi.Error); ok || errors.As(err, &ge) {
+	if ge, ok := errcode.As[*googleapi.Error](err); ok {
 		return httperr.ToGRPC(ge.Code)
 	}
 	return codes.Unknown
 }
+
+// SEE: https://cloud.google.com/apis/design/errors#error_info
+// SEE: https://cloud.google.com/apis/design/errors#error_model
+// SEE: https://developers.google.com/drive/api/guides/handle-errors
+var reasonCodes = map[string]codes.Code{
+	// ResourceExhausted
+	"RATE_LIMIT_EXCEEDED":   codes.ResourceExhausted,
+	"RESOURCE_EXHAUSTED":    codes.ResourceExhausted,
+	"rateLimitExceeded":     codes.ResourceExhausted, // Legacy
+	"userRateLimitExceeded": codes.ResourceExhausted, // Legacy
+	"dailyLimitExceeded":    codes.ResourceExhausted, // Legacy
+	"quotaExceeded":         codes.ResourceExhausted, // Legacy
+
+	// Unauthenticated
+	"ACCESS_TOKEN_EXPIRED":          codes.Unauthenticated,
+	"ACCESS_TOKEN_TYPE_UNSUPPORTED": codes.Unauthenticated,
+	"CREDENTIALS_MISSING":           codes.Unauthenticated,
+	"authError":                     codes.Unauthenticated, // Legacy
+
+	// PermissionDenied
+	"ACCESS_TOKEN_SCOPE_INSUFFICIENT": codes.PermissionDenied,
+	"IAM_PERMISSION_DENIED":           codes.PermissionDenied,
+	"SERVICE_DISABLED":                codes.PermissionDenied,
+	"API_KEY_SERVICE_BLOCKED":         codes.PermissionDenied,
+	"insufficientPermissions":         codes.PermissionDenied, // Legacy
+}
+
+// ReasonCode returns the gRPC code associated with the given ErrorInfo reason.
+// If the reason isn't recognized, it returns codes.Unknown.
+func ReasonCode(reason string) codes.Code {
+	if code, ok := reasonCodes[reason]; ok {
+		return code
+	}
+	return codes.Unknown
+}
+
+func reasonErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	reason, _ := errorInfo(err)
+	return ReasonCode(reason)
+}
+
+// Reason returns the reason of the ErrorInfo attached to the given error,
+// if there is one. For legacy errors without an ErrorInfo, it returns the
+// reason of the first *googleapi.Error item, such as "rateLimitExceeded".
+func Reason(err error) string {
+	reason, _ := errorInfo(err)
+	return reason
+}
+
+// Domain returns the domain of the ErrorInfo attached to the given error,
+// such as "googleapis.com", if there is one.
+func Domain(err error) string {
+	_, domain := errorInfo(err)
+	return domain
+}
+
+const errorInfoType = "type.googleapis.com/google.rpc.ErrorInfo"
+
+// errorInfo returns the reason and domain of the first ErrorInfo
+// found in an *apierror.APIError, a gRPC status, or the JSON details
+// of a *googleapi.Error.
+func errorInfo(err error) (reason, domain string) {
+	if err == nil {
+		return "", ""
+	}
+	if ae, ok := errcode.As[*apierror.APIError](err); ok && ae.Reason() != "" {
+		return ae.Reason(), ae.Domain()
+	}
+	if info := grpcerr.ErrorInfo(err); info != nil {
+		return info.GetReason(), info.GetDomain()
+	}
+	if ge, ok := errcode.As[*googleapi.Error](err); ok {
+		for _, d := range ge.Details {
+			if m, ok := d.(map[string]any); ok && m["@type"] == errorInfoType {
+				reason, _ := m["reason"].(string)
+				domain, _ := m["domain"].(string)
+				return reason, domain
+			}
+		}
+		if len(ge.Errors) > 0 {
+			return ge.Errors[0].Reason, ""
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package googleapierr
+
+import (
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func statusWithReason(code codes.Code, reason, domain string) error {
+	s, err := status.New(code, "error").WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: domain})
+	if err != nil {
+		panic(err)
+	}
+	return s.Err()
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "status", Err: status.Error(codes.NotFound, "missing"), Want: codes.NotFound},
+		{Name: "status reason", Err: statusWithReason(codes.PermissionDenied, "RATE_LIMIT_EXCEEDED", "googleapis.com"), Want: codes.ResourceExhausted},
+		{Name: "http", Err: &googleapi.Error{Code: http.StatusConflict}, Want: codes.Aborted},
+		{Name: "legacy reason", Err: &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "userRateLimitExceeded"}}}, Want: codes.ResourceExhausted},
+		{Name: "details reason", Err: &googleapi.Error{
+			Code:    http.StatusForbidden,
+			Details: []any{map[string]any{"@type": errorInfoType, "reason": "SERVICE_DISABLED", "domain": "googleapis.com"}},
+		}, Want: codes.PermissionDenied},
+	})
+}
+
+func TestReason(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		err    error
+		reason string
+		domain string
+	}{
+		{name: "nil"},
+		{name: "status", err: statusWithReason(codes.Unauthenticated, "ACCESS_TOKEN_EXPIRED", "googleapis.com"), reason: "ACCESS_TOKEN_EXPIRED", domain: "googleapis.com"},
+		{name: "legacy", err: &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "quotaExceeded"}}}, reason: "quotaExceeded"},
+		{name: "none", err: &googleapi.Error{Code: http.StatusNotFound}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reason(tt.err); got != tt.reason {
+				t.Errorf("Reason() = %q; want %q", got, tt.reason)
+			}
+			if got := Domain(tt.err); got != tt.domain {
+				t.Errorf("Domain() = %q; want %q", got, tt.domain)
+			}
+		})
+	}
+}
@@ -3,13 +3,18 @@ module bursavich.dev/errcode/googleapierr
 go 1.24.0
 
 require (
-	bursavich.dev/errcode v0.1.0
+	bursavich.dev/errcode v0.2.0
+	github.com/googleapis/gax-go/v2 v2.14.2
 	google.golang.org/api v0.235.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a
 	google.golang.org/grpc v1.72.2
 )
 
 require (
+	golang.org/x/net v0.40.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
+	golang.org/x/text v0.25.0 // indirect
 	google.golang.org/protobuf v1.36.6 // indirect
 )
+
+replace bursavich.dev/errcode => ../
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package googleapierr
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"bursavich.dev/errcode/httperr"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+// Retryable reports whether the call that returned the error may be retried,
+// even if it isn't idempotent, because the request wasn't processed:
+// the server was overloaded (HTTP 429 or 503, or a gRPC ResourceExhausted
+// or Unavailable status) or the connection was refused.
+//
+// Context errors are never retryable.
+func Retryable(err error) bool {
+	if err == nil || errcode.Is(err, context.Canceled) || errcode.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if status, ok := httpStatus(err); ok {
+		return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+	}
+	switch grpcerr.ErrorCode(err) {
+	case codes.ResourceExhausted, codes.Unavailable:
+		return true
+	}
+	return isConnRefused(err)
+}
+
+// RetryableIdempotent reports whether the idempotent call that returned the
+// error may be retried. Like the retry predicates of the google-cloud-go
+// clients, it extends Retryable with failures after which the request may
+// or may not have been processed: HTTP 500, 502, and 504 statuses,
+// gRPC Internal and DeadlineExceeded statuses, reset connections,
+// unexpected EOFs, and network timeouts.
+//
+// Context errors are never retryable.
+func RetryableIdempotent(err error) bool {
+	if err == nil || errcode.Is(err, context.Canceled) || errcode.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if Retryable(err) {
+		return true
+	}
+	if status, ok := httpStatus(err); ok {
+		switch status {
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	switch grpcerr.ErrorCode(err) {
+	case codes.Internal, codes.DeadlineExceeded:
+		return true
+	}
+	if isConnReset(err) || errcode.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if e, ok := errcode.As[net.Error](err); ok && e.Timeout() {
+		return true
+	}
+	// The transports don't always preserve the underlying syscall error.
+	msg := err.Error()
+	return strings.Contains(msg, "connection reset") || strings.Contains(msg, "broken pipe")
+}
+
+// httpStatus returns the HTTP status of the first error in the chain that
+// has one, unless the error has a gRPC status.
+func httpStatus(err error) (int, bool) {
+	if grpcerr.ErrorCode(err) != codes.Unknown {
+		return 0, false
+	}
+	if e, ok := errcode.As[httperr.Error](err); ok && e.HTTPCode() > 0 {
+		return e.HTTPCode(), true
+	}
+	if e, ok := errcode.As[*googleapi.Error](err); ok {
+		return e.Code, true
+	}
+	return 0, false
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package googleapierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryable(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		err        error
+		retryable  bool
+		idempotent bool
+	}{
+		{name: "nil"},
+		{name: "canceled", err: context.Canceled},
+		{name: "deadline exceeded", err: fmt.Errorf("call: %w", context.DeadlineExceeded)},
+		{name: "too many requests", err: &googleapi.Error{Code: http.StatusTooManyRequests}, retryable: true, idempotent: true},
+		{name: "service unavailable", err: &googleapi.Error{Code: http.StatusServiceUnavailable}, retryable: true, idempotent: true},
+		{name: "internal server error", err: &googleapi.Error{Code: http.StatusInternalServerError}, idempotent: true},
+		{name: "bad gateway", err: &googleapi.Error{Code: http.StatusBadGateway}, idempotent: true},
+		{name: "not found", err: &googleapi.Error{Code: http.StatusNotFound}},
+		{name: "unavailable status", err: status.Error(codes.Unavailable, "busy"), retryable: true, idempotent: true},
+		{name: "resource exhausted status", err: status.Error(codes.ResourceExhausted, "quota"), retryable: true, idempotent: true},
+		{name: "internal status", err: status.Error(codes.Internal, "oops"), idempotent: true},
+		{name: "deadline exceeded status", err: status.Error(codes.DeadlineExceeded, "slow"), idempotent: true},
+		{name: "invalid argument status", err: status.Error(codes.InvalidArgument, "bad")},
+		{name: "unexpected eof", err: io.ErrUnexpectedEOF, idempotent: true},
+		{name: "connection reset message", err: errors.New("read tcp: connection reset by peer"), idempotent: true},
+		{name: "other", err: errors.New("other")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retryable(tt.err); got != tt.retryable {
+				t.Errorf("Retryable() = %v; want %v", got, tt.retryable)
+			}
+			if got := RetryableIdempotent(tt.err); got != tt.idempotent {
+				t.Errorf("RetryableIdempotent() = %v; want %v", got, tt.idempotent)
+			}
+		})
+	}
+}
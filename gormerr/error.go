@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gormerr provides the ability to extract the status code from GORM errors
+// from the gorm.io/gorm package.
+//
+// Unless TranslateError is enabled, GORM returns driver errors as they are,
+// so it should be used with the coders of the underlying drivers (see WithDrivers).
+package gormerr
+
+import (
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the GORM ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// WithDrivers returns an ErrorCoder that consults the GORM ErrorCoder followed
+// by the given driver-level ErrorCoders (e.g. pgerr, mysqlerr, sqliteerr, and sqlerr).
+func WithDrivers(drivers ...errcode.ErrorCoder) errcode.ErrorCoder {
+	return errcode.Compact(append([]errcode.ErrorCoder{errorCoder}, drivers...)...)
+}
+
+var errorCodes = map[error]codes.Code{
+	gorm.ErrRecordNotFound: codes.NotFound,
+
+	gorm.ErrDuplicatedKey: codes.AlreadyExists,
+
+	gorm.ErrForeignKeyViolated:      codes.FailedPrecondition,
+	gorm.ErrCheckConstraintViolated: codes.FailedPrecondition,
+	gorm.ErrInvalidTransaction:      codes.FailedPrecondition,
+
+	gorm.ErrInvalidData:          codes.InvalidArgument,
+	gorm.ErrEmptySlice:           codes.InvalidArgument,
+	gorm.ErrInvalidValueOfLength: codes.InvalidArgument,
+
+	gorm.ErrNotImplemented:        codes.Unimplemented,
+	gorm.ErrUnsupportedRelation:   codes.Unimplemented,
+	gorm.ErrUnsupportedDriver:     codes.Unimplemented,
+	gorm.ErrDryRunModeUnsupported: codes.Unimplemented,
+
+	// Misuse of the API.
+	gorm.ErrMissingWhereClause:            codes.Internal,
+	gorm.ErrPrimaryKeyRequired:            codes.Internal,
+	gorm.ErrModelValueRequired:            codes.Internal,
+	gorm.ErrModelAccessibleFieldsRequired: codes.Internal,
+	gorm.ErrSubQueryRequired:              codes.Internal,
+	gorm.ErrRegistered:                    codes.Internal,
+	gorm.ErrInvalidField:                  codes.Internal,
+	gorm.ErrInvalidDB:                     codes.Internal,
+	gorm.ErrInvalidValue:                  codes.Internal,
+	gorm.ErrPreloadNotAllowed:             codes.Internal,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known GORM error.
+func ErrorCode(err error) codes.Code {
+	return sentinelCoder.ErrorCode(err)
+}
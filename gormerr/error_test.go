@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gormerr
+
+import (
+	"database/sql"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"bursavich.dev/errcode/sqlerr"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "record not found", Err: gorm.ErrRecordNotFound, Want: codes.NotFound},
+		{Name: "duplicated key", Err: gorm.ErrDuplicatedKey, Want: codes.AlreadyExists},
+		{Name: "foreign key violated", Err: gorm.ErrForeignKeyViolated, Want: codes.FailedPrecondition},
+		{Name: "invalid transaction", Err: gorm.ErrInvalidTransaction, Want: codes.FailedPrecondition},
+		{Name: "missing where clause", Err: gorm.ErrMissingWhereClause, Want: codes.Internal},
+	})
+}
+
+func TestWithDrivers(t *testing.T) {
+	errcodetest.TestErrorCoder(t, WithDrivers(sqlerr.ErrorCoder()), []errcodetest.Case{
+		{Name: "gorm", Err: gorm.ErrRecordNotFound, Want: codes.NotFound},
+		{Name: "driver", Err: sql.ErrConnDone, Want: codes.Unavailable},
+	})
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gqlerr provides the ability to extract the status code from GraphQL errors
+// from the github.com/vektah/gqlparser/v2/gqlerror package and to attach codes to
+// the errors presented by github.com/99designs/gqlgen servers.
+//
+// Codes are carried by the "code" extension of an error. Both canonical gRPC code
+// names, such as "NOT_FOUND", and the codes used by Apollo Server are recognized.
+package gqlerr
+
+import (
+	"context"
+
+	"bursavich.dev/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+)
+
+// CodeExtension is the key of the extension that carries an error's code.
+const CodeExtension = "code"
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the GraphQL ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://www.apollographql.com/docs/apollo-server/data/errors#built-in-error-codes
+
+var apolloCodes = map[string]codes.Code{
+	"BAD_REQUEST":                   codes.InvalidArgument,
+	"BAD_USER_INPUT":                codes.InvalidArgument,
+	"GRAPHQL_PARSE_FAILED":          codes.InvalidArgument,
+	"GRAPHQL_VALIDATION_FAILED":     codes.InvalidArgument,
+	"OPERATION_RESOLUTION_FAILURE":  codes.InvalidArgument,
+	"PERSISTED_QUERY_NOT_FOUND":     codes.NotFound,
+	"FORBIDDEN":                     codes.PermissionDenied,
+	"PERSISTED_QUERY_NOT_SUPPORTED": codes.Unimplemented,
+	"INTERNAL_SERVER_ERROR":         codes.Internal,
+	"UNAUTHENTICATED":               codes.Unauthenticated,
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *gqlerror.Error, or a gqlerror.List, with a
+// recognized code extension. The first recognized code in a list is used.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if list, ok := errcode.As[gqlerror.List](err); ok {
+		for _, e := range list {
+			if code := extensionCode(e); code != codes.Unknown {
+				return code
+			}
+		}
+		return codes.Unknown
+	}
+	if e, ok := errcode.As[*gqlerror.Error](err); ok {
+		return extensionCode(e)
+	}
+	return codes.Unknown
+}
+
+func extensionCode(e *gqlerror.Error) codes.Code {
+	if e == nil {
+		return codes.Unknown
+	}
+	s, ok := e.Extensions[CodeExtension].(string)
+	if !ok {
+		return codes.Unknown
+	}
+	if code, ok := apolloCodes[s]; ok {
+		return code
+	}
+	// A presented error is never OK, so such a code is ignored.
+	if code, err := errcode.ParseCode(s); err == nil && code != codes.OK {
+		return code
+	}
+	return codes.Unknown
+}
+
+// ErrorPresenter returns a function that presents errors with the canonical
+// name of the code resolved by the given ErrorCoder in their code extension,
+// unless they already have one. It is compatible with gqlgen's
+// graphql.ErrorPresenterFunc and is installed with the server's
+// SetErrorPresenter method.
+//
+// Like gqlgen's default presenter, it presents the *gqlerror.Error in the
+// error's chain, which carries the error's path, or wraps the error.
+func ErrorPresenter(coder errcode.ErrorCoder) func(context.Context, error) *gqlerror.Error {
+	return func(_ context.Context, err error) *gqlerror.Error {
+		gqlErr, ok := errcode.As[*gqlerror.Error](err)
+		if !ok {
+			gqlErr = gqlerror.Wrap(err)
+		}
+		if gqlErr == nil {
+			return nil
+		}
+		if _, ok := gqlErr.Extensions[CodeExtension]; ok {
+			return gqlErr
+		}
+		code := errcode.ResolveError(coder, err)
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = make(map[string]any, 1)
+		}
+		gqlErr.Extensions[CodeExtension] = errcode.CodeString(code)
+		return gqlErr
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gqlerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+)
+
+func withCode(code string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message:    "failed",
+		Extensions: map[string]any{CodeExtension: code},
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"nil", nil, codes.OK},
+		{"canonical", withCode("NOT_FOUND"), codes.NotFound},
+		{"apollo", withCode("BAD_USER_INPUT"), codes.InvalidArgument},
+		{"wrapped", fmt.Errorf("resolve: %w", withCode("UNAUTHENTICATED")), codes.Unauthenticated},
+		{"unrecognized", withCode("TEAPOT"), codes.Unknown},
+		{"ok", withCode("OK"), codes.Unknown},
+		{"ok list", gqlerror.List{withCode("OK"), withCode("NOT_FOUND")}, codes.NotFound},
+		{"no extension", gqlerror.Errorf("failed"), codes.Unknown},
+		{"list", gqlerror.List{withCode("TEAPOT"), withCode("FORBIDDEN")}, codes.PermissionDenied},
+		{"other", errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("%s: got %v; want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "canonical", Err: withCode("NOT_FOUND"), Want: codes.NotFound},
+		{Name: "apollo", Err: withCode("BAD_USER_INPUT"), Want: codes.InvalidArgument},
+		{Name: "list", Err: gqlerror.List{withCode("TEAPOT"), withCode("FORBIDDEN")}, Want: codes.PermissionDenied},
+		{Name: "ok", Err: withCode("OK"), Want: codes.Unknown},
+	})
+}
+
+func TestErrorPresenter(t *testing.T) {
+	path := ast.Path{ast.PathName("user")}
+	tests := []struct {
+		name string
+		err  error
+		code string
+		path ast.Path
+	}{
+		{
+			name: "coded",
+			err:  fmt.Errorf("load: %w", fs.ErrNotExist),
+			code: "NOT_FOUND",
+		},
+		{
+			name: "uncoded",
+			err:  errors.New("boom"),
+			code: "UNKNOWN",
+		},
+		{
+			name: "path",
+			err:  &gqlerror.Error{Err: fs.ErrPermission, Message: "denied", Path: path},
+			code: "PERMISSION_DENIED",
+			path: path,
+		},
+		{
+			name: "existing",
+			err:  fmt.Errorf("resolve: %w", &gqlerror.Error{Err: fs.ErrNotExist, Message: "missing", Extensions: map[string]any{CodeExtension: "BAD_USER_INPUT"}}),
+			code: "BAD_USER_INPUT",
+		},
+	}
+	present := ErrorPresenter(errcode.FileSystemErrorCoder())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := present(context.Background(), tt.err)
+			if code := got.Extensions[CodeExtension]; code != tt.code {
+				t.Errorf("code: got %v; want %v", code, tt.code)
+			}
+			if got.Path.String() != tt.path.String() {
+				t.Errorf("path: got %v; want %v", got.Path, tt.path)
+			}
+		})
+	}
+}
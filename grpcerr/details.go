@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcerr
+
+import (
+	"time"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorInfo returns the first ErrorInfo detail of the gRPC status
+// in the given error's chain, or nil if there isn't one.
+func ErrorInfo(err error) *errdetails.ErrorInfo {
+	return detail[*errdetails.ErrorInfo](err)
+}
+
+// Reason returns the domain and reason of the given error, as by errcode.Reason,
+// or else those of the first ErrorInfo detail of the gRPC status in its chain,
+// and reports whether it has either.
+func Reason(err error) (domain, reason string, ok bool) {
+	if domain, reason, ok := errcode.Reason(err); ok {
+		return domain, reason, true
+	}
+	if info := ErrorInfo(err); info != nil {
+		return info.GetDomain(), info.GetReason(), true
+	}
+	return "", "", false
+}
+
+// WithErrorDetails returns a copy of the status with details that describe the
+// given error: an ErrorInfo detail with its reason and metadata, if it has a
+// reason, and a RequestInfo detail with its request ID, if it has one. Details
+// of types that the status already has aren't added. If there aren't any new
+// details or the status's code is OK, it's returned unchanged.
+func WithErrorDetails(s *status.Status, err error) *status.Status {
+	var hasInfo, hasRequest bool
+	for _, d := range s.Details() {
+		switch d.(type) {
+		case *errdetails.ErrorInfo:
+			hasInfo = true
+		case *errdetails.RequestInfo:
+			hasRequest = true
+		}
+	}
+	var details []protoadapt.MessageV1
+	if domain, reason, ok := errcode.Reason(err); ok && !hasInfo {
+		details = append(details, &errdetails.ErrorInfo{
+			Domain:   domain,
+			Reason:   reason,
+			Metadata: errcode.Metadata(err),
+		})
+	}
+	if id, ok := errcode.RequestID(err); ok && !hasRequest {
+		details = append(details, &errdetails.RequestInfo{RequestId: id})
+	}
+	if len(details) == 0 {
+		return s
+	}
+	if ds, err := s.WithDetails(details...); err == nil {
+		return ds
+	}
+	return s
+}
+
+// BadRequest returns the first BadRequest detail of the gRPC status
+// in the given error's chain, or nil if there isn't one.
+func BadRequest(err error) *errdetails.BadRequest {
+	return detail[*errdetails.BadRequest](err)
+}
+
+// QuotaFailure returns the first QuotaFailure detail of the gRPC status
+// in the given error's chain, or nil if there isn't one.
+func QuotaFailure(err error) *errdetails.QuotaFailure {
+	return detail[*errdetails.QuotaFailure](err)
+}
+
+// RequestInfo returns the first RequestInfo detail of the gRPC status
+// in the given error's chain, or nil if there isn't one.
+func RequestInfo(err error) *errdetails.RequestInfo {
+	return detail[*errdetails.RequestInfo](err)
+}
+
+// RetryInfo returns the first RetryInfo detail of the gRPC status
+// in the given error's chain, or nil if there isn't one.
+func RetryInfo(err error) *errdetails.RetryInfo {
+	return detail[*errdetails.RetryInfo](err)
+}
+
+// StatusRetryDelay returns the delay of the first RetryInfo detail
+// of the status and reports whether it has one.
+func StatusRetryDelay(s *status.Status) (time.Duration, bool) {
+	for _, d := range s.Details() {
+		if v, ok := d.(*errdetails.RetryInfo); ok && v.GetRetryDelay() != nil {
+			return v.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// detail returns the first detail of type T of the first gRPC status
+// in the given error's chain.
+func detail[T proto.Message](err error) T {
+	var zero T
+	e, ok := errcode.As[Error](err)
+	if !ok {
+		return zero
+	}
+	for _, d := range e.GRPCStatus().Details() {
+		if v, ok := d.(T); ok {
+			return v
+		}
+	}
+	return zero
+}
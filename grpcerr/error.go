@@ -8,7 +8,7 @@
 package grpcerr
 
 import (
-	"errors"
+	"time"
 
 	"bursavich.dev/errcode"
 	"google.golang.org/grpc/codes"
@@ -21,6 +21,70 @@ type Error interface {
 	error
 }
 
+// New wraps the given error and adds an explicit gRPC status.
+// The status may carry details, which are preserved.
+func New(s *status.Status, err error) error {
+	return &statusError{s, err}
+}
+
+type statusError struct {
+	s   *status.Status
+	err error
+}
+
+func (se *statusError) GRPCStatus() *status.Status { return se.s }
+func (se *statusError) Code() codes.Code           { return se.s.Code() }
+func (se *statusError) Error() string              { return se.err.Error() }
+func (se *statusError) Unwrap() error              { return se.err }
+
+// RetryDelay returns the delay of the status's RetryInfo detail
+// and reports whether it has one. It implements errcode.RetryDelayError.
+func (se *statusError) RetryDelay() (time.Duration, bool) { return StatusRetryDelay(se.s) }
+
+// From returns the gRPC status of the given error. If the error or
+// any error in its chain has a status, that status is returned with
+// the message of the whole error. Otherwise, a status with the error's
+// message and the code resolved by the coders is returned. In either case,
+// the message is replaced by the error's public message, if it has one, and
+// details describing the error's reason and request ID are added, as by
+// WithErrorDetails.
+// If the error is nil, it returns nil, which represents OK.
+func From(err error, coders ...errcode.ErrorCoder) *status.Status {
+	if err == nil {
+		return nil
+	}
+	return WithErrorDetails(fromError(err, coders), err)
+}
+
+func fromError(err error, coders []errcode.ErrorCoder) *status.Status {
+	msg, public := errcode.PublicMessage(err)
+	if s, ok := statusFromError(err); ok {
+		if public {
+			return withMessage(s, msg)
+		}
+		return s
+	}
+	if !public {
+		msg = err.Error()
+	}
+	return status.New(errcode.Compact(coders...).ErrorCode(err), msg)
+}
+
+// statusFromError is like status.FromError, but it's limited by errcode.SetMaxDepth.
+func statusFromError(err error) (*status.Status, bool) {
+	if !errcode.WithinLimits(err) {
+		return nil, false
+	}
+	return status.FromError(err)
+}
+
+// withMessage returns a copy of the status with the given message.
+func withMessage(s *status.Status, msg string) *status.Status {
+	p := s.Proto()
+	p.Message = msg
+	return status.FromProto(p)
+}
+
 var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
 
 // ErrorCoder return the gRPC ErrorCoder.
@@ -34,8 +98,8 @@ func ErrorCode(err error) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	gs, ok := err.(Error)
-	if !ok && !errors.As(err, &gs) {
+	gs, ok := errcode.As[Error](err)
+	if !ok {
 		return codes.Unknown
 	}
 	s := gs.GRPCStatus()
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+	"time"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestNew(t *testing.T) {
+	s, err := status.New(codes.InvalidArgument, "bad name").WithDetails(&errdetails.ErrorInfo{Reason: "NAME"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cause := errors.New("name is empty")
+	err = fmt.Errorf("create: %w", New(s, cause))
+
+	if !errors.Is(err, cause) {
+		t.Error("error chain doesn't contain the cause")
+	}
+	if got := ErrorCode(err); got != codes.InvalidArgument {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.InvalidArgument)
+	}
+	if got := errcode.CodedErrorCoder().ErrorCode(err); got != codes.InvalidArgument {
+		t.Errorf("CodedErrorCoder: got %v; want %v", got, codes.InvalidArgument)
+	}
+	got := From(err)
+	if got.Code() != codes.InvalidArgument || got.Message() != "create: name is empty" || len(got.Details()) != 1 {
+		t.Errorf("From: got %v with details %v", got, got.Details())
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "status", Err: status.Error(codes.NotFound, "missing"), Want: codes.NotFound},
+		{Name: "new", Err: New(status.New(codes.Aborted, "conflict"), errors.New("version mismatch")), Want: codes.Aborted},
+	})
+}
+
+// cycleError is a buggy error whose Unwrap method returns itself.
+type cycleError struct{}
+
+func (e *cycleError) Error() string { return "cycle" }
+func (e *cycleError) Unwrap() error { return e }
+
+func TestFromCycle(t *testing.T) {
+	if s := From(&cycleError{}); s.Code() != codes.Unknown || s.Message() != "cycle" {
+		t.Errorf("From: got %v", s)
+	}
+}
+
+func TestFrom(t *testing.T) {
+	if s := From(nil); s != nil {
+		t.Errorf("From(nil): got %v; want nil", s)
+	}
+	err := fmt.Errorf("query: %w", context.Canceled)
+	s := From(err, errcode.ContextErrorCoder())
+	if s.Code() != codes.Canceled || s.Message() != err.Error() {
+		t.Errorf("From: got %v", s)
+	}
+	if s := From(errors.New("boom")); s.Code() != codes.Unknown {
+		t.Errorf("From: got %v; want %v", s.Code(), codes.Unknown)
+	}
+}
+
+func TestDetails(t *testing.T) {
+	s, err := status.New(codes.ResourceExhausted, "slow down").WithDetails(
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{Subject: "project:1"}}},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(3 * time.Second)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = fmt.Errorf("call: %w", s.Err())
+
+	if got := QuotaFailure(err); got.GetViolations()[0].GetSubject() != "project:1" {
+		t.Errorf("QuotaFailure: got %v", got)
+	}
+	if got := RetryInfo(err); got.GetRetryDelay().AsDuration() != 3*time.Second {
+		t.Errorf("RetryInfo: got %v", got)
+	}
+	if got := ErrorInfo(err); got != nil {
+		t.Errorf("ErrorInfo: got %v; want nil", got)
+	}
+	if delay, ok := errcode.ThrottleDelay(New(s, errors.New("quota")), ErrorCoder()); delay != 3*time.Second || !ok {
+		t.Errorf("ThrottleDelay: got (%v, %v); want (3s, true)", delay, ok)
+	}
+	if got := BadRequest(errors.New("plain")); got != nil {
+		t.Errorf("BadRequest: got %v; want nil", got)
+	}
+}
+
+func TestReason(t *testing.T) {
+	if domain, reason, ok := Reason(errors.New("plain")); ok {
+		t.Errorf("Reason: got (%q, %q); want none", domain, reason)
+	}
+
+	err := errcode.WithReason(errcode.New(codes.NotFound, errors.New("no rows")), "example.com", "USER_NOT_FOUND")
+	s := From(err, errcode.CodedErrorCoder())
+	if s.Code() != codes.NotFound {
+		t.Errorf("From: got %v; want %v", s.Code(), codes.NotFound)
+	}
+	if info := ErrorInfo(s.Err()); info.GetDomain() != "example.com" || info.GetReason() != "USER_NOT_FOUND" {
+		t.Errorf("ErrorInfo: got %v", info)
+	}
+	if domain, reason, ok := Reason(fmt.Errorf("call: %w", s.Err())); !ok || domain != "example.com" || reason != "USER_NOT_FOUND" {
+		t.Errorf("Reason: got (%q, %q, %v)", domain, reason, ok)
+	}
+
+	// An existing ErrorInfo detail is preserved.
+	ds, _ := status.New(codes.InvalidArgument, "bad name").WithDetails(&errdetails.ErrorInfo{Reason: "NAME"})
+	s = From(errcode.WithReason(ds.Err(), "example.com", "OTHER"))
+	if len(s.Details()) != 1 || ErrorInfo(s.Err()).GetReason() != "NAME" {
+		t.Errorf("From: got details %v", s.Details())
+	}
+	if got := WithErrorDetails(status.New(codes.OK, ""), errcode.WithReason(errors.New("ok"), "example.com", "OK")); len(got.Details()) != 0 {
+		t.Errorf("WithErrorDetails(OK): got details %v", got.Details())
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	err := errcode.WithRequestID(errcode.WithMetadata(
+		errcode.WithReason(errcode.New(codes.NotFound, errors.New("no rows")), "example.com", "USER_NOT_FOUND"),
+		"user", "42",
+	), "req-1")
+	s := From(err, errcode.CodedErrorCoder())
+	if got := RequestInfo(s.Err()).GetRequestId(); got != "req-1" {
+		t.Errorf("RequestInfo: got %q; want %q", got, "req-1")
+	}
+	want := map[string]string{errcode.RequestIDKey: "req-1", "user": "42"}
+	if got := ErrorInfo(s.Err()).GetMetadata(); !maps.Equal(got, want) {
+		t.Errorf("ErrorInfo metadata: got %v; want %v", got, want)
+	}
+
+	// A request ID is sent without a reason.
+	s = From(errcode.WithRequestID(errors.New("boom"), "req-2"))
+	if len(s.Details()) != 1 || RequestInfo(s.Err()).GetRequestId() != "req-2" {
+		t.Errorf("From: got details %v", s.Details())
+	}
+}
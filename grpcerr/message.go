@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcerr
+
+import (
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// genericMessages are the messages that replace those of errors with codes
+// that indicate server faults, whose messages may describe internal details
+// such as SQL queries or file paths.
+var genericMessages = map[codes.Code]string{
+	codes.Unknown:     "unknown error",
+	codes.Internal:    "internal error",
+	codes.Unavailable: "service unavailable",
+	codes.DataLoss:    "data loss",
+}
+
+// SanitizeMessage returns the message of the given error if its code
+// indicates a client fault, or a generic message if its code is Unknown,
+// Internal, Unavailable, or DataLoss, which indicate server faults.
+// An error's public message is always returned, if it has one.
+// If the error is nil, it returns an empty string.
+//
+// Its signature is compatible with the WithMessageFunc options of the
+// grpcmw and httpmw packages.
+func SanitizeMessage(code codes.Code, err error) string {
+	if err == nil {
+		return ""
+	}
+	if msg, ok := errcode.PublicMessage(err); ok {
+		return msg
+	}
+	if msg, ok := genericMessages[code]; ok {
+		return msg
+	}
+	return err.Error()
+}
+
+// Sanitize returns a status error for the given error, whose message is
+// replaced with a generic message if its code indicates a server fault,
+// unless the error has a public message.
+// The status's code and details are preserved. If the error is nil,
+// it returns nil.
+//
+// The status is resolved like From, so errors without a status are
+// converted with the code resolved by the coders.
+func Sanitize(err error, coders ...errcode.ErrorCoder) error {
+	s := From(err, coders...)
+	if s == nil {
+		return nil
+	}
+	if _, ok := errcode.PublicMessage(err); ok {
+		return s.Err()
+	}
+	msg, ok := genericMessages[s.Code()]
+	if !ok {
+		return s.Err()
+	}
+	return withMessage(s, msg).Err()
+}
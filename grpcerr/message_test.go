@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcerr
+
+import (
+	"errors"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSanitizeMessage(t *testing.T) {
+	err := errors.New(`pq: relation "users" does not exist`)
+	if got, want := SanitizeMessage(codes.Internal, err), "internal error"; got != want {
+		t.Errorf("Internal: got %q; want %q", got, want)
+	}
+	if got, want := SanitizeMessage(codes.NotFound, err), err.Error(); got != want {
+		t.Errorf("NotFound: got %q; want %q", got, want)
+	}
+	if got := SanitizeMessage(codes.Unknown, nil); got != "" {
+		t.Errorf("nil: got %q; want empty", got)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	if err := Sanitize(nil); err != nil {
+		t.Errorf("nil: got %v; want nil", err)
+	}
+
+	err := Sanitize(errors.New("open /etc/secret: permission denied"))
+	if s := status.Convert(err); s.Code() != codes.Unknown || s.Message() != "unknown error" {
+		t.Errorf("unknown: got %v", s)
+	}
+
+	err = Sanitize(errors.New("invalid name"), errcode.FromFunc(func(error) codes.Code { return codes.InvalidArgument }))
+	if s := status.Convert(err); s.Code() != codes.InvalidArgument || s.Message() != "invalid name" {
+		t.Errorf("invalid argument: got %v", s)
+	}
+
+	s, _ := status.New(codes.Internal, "query failed: SELECT *").WithDetails(&errdetails.ErrorInfo{Reason: "DB"})
+	err = Sanitize(s.Err())
+	if got := status.Convert(err); got.Message() != "internal error" || ErrorInfo(err).GetReason() != "DB" {
+		t.Errorf("internal: got %v with details %v", got, got.Details())
+	}
+}
+
+func TestPublicMessage(t *testing.T) {
+	internal := errors.New(`pq: relation "users" does not exist`)
+	err := errcode.WithPublicMessage(internal, "try again later")
+	if got, want := SanitizeMessage(codes.Internal, err), "try again later"; got != want {
+		t.Errorf("SanitizeMessage: got %q; want %q", got, want)
+	}
+
+	coder := errcode.FromFunc(func(error) codes.Code { return codes.Internal })
+	if s := From(err, coder); s.Code() != codes.Internal || s.Message() != "try again later" {
+		t.Errorf("From: got %v", s)
+	}
+	if s := status.Convert(Sanitize(err, coder)); s.Code() != codes.Internal || s.Message() != "try again later" {
+		t.Errorf("Sanitize: got %v", s)
+	}
+
+	s, _ := status.New(codes.Aborted, "conflict on row 7").WithDetails(&errdetails.ErrorInfo{Reason: "CONFLICT"})
+	err = errcode.WithPublicMessage(New(s, internal), "conflict")
+	if got := From(err); got.Code() != codes.Aborted || got.Message() != "conflict" || ErrorInfo(got.Err()).GetReason() != "CONFLICT" {
+		t.Errorf("From: got %v with details %v", got, got.Details())
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcerr
+
+import (
+	"io"
+	"net"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/neterr"
+	"google.golang.org/grpc/codes"
+)
+
+var transportErrorCoder errcode.ErrorCoder = errcode.FromFunc(TransportErrorCode)
+
+// TransportErrorCoder returns the gRPC transport ErrorCoder.
+func TransportErrorCoder() errcode.ErrorCoder {
+	return transportErrorCoder
+}
+
+var transportCauseCoder = errcode.ErrorCoders{
+	errcode.ContextErrorCoder(),
+	errcode.FromFunc(resolverErrorCode),
+	neterr.ErrorCoder(),
+	errcode.TransientErrorCoder(),
+}
+
+// SEE: https://github.com/grpc/grpc-go/blob/master/internal/transport/transport.go
+var transportMessageCoder = errcode.MatchMessage([]errcode.MessageRule{
+	{Contains: "transport is closing", Code: codes.Unavailable},
+	{Contains: "received prior goaway", Code: codes.Unavailable},
+	{Contains: "connection closed before server preface received", Code: codes.Unavailable},
+	{Contains: "error reading from server", Code: codes.Unavailable},
+	{Contains: "connection refused", Code: codes.Unavailable},
+	{Contains: "connection reset by peer", Code: codes.Unavailable},
+	{Contains: "name resolver error", Code: codes.Unavailable},
+})
+
+// TransportErrorCode returns the gRPC code associated with the given error
+// if it's a failure to reach a gRPC server that doesn't have a meaningful
+// status of its own:
+//
+//   - context errors, such as a deadline exceeded while dialing, keep their codes;
+//   - an unexpected io.EOF or io.ErrUnexpectedEOF is Unavailable;
+//   - refused or reset connections and name resolution failures are Unavailable;
+//   - messages of gRPC transport failures, such as "transport is closing"
+//     or "received prior goaway", are Unavailable.
+//
+// It's intended for client errors without a status or with an Unknown status.
+// Callers reading a stream must check for the io.EOF that signals its end first.
+func TransportErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if errcode.Is(err, io.EOF) || errcode.Is(err, io.ErrUnexpectedEOF) {
+		return codes.Unavailable
+	}
+	if code := transportCauseCoder.ErrorCode(err); code != codes.Unknown {
+		return code
+	}
+	return transportMessageCoder.ErrorCode(err)
+}
+
+// resolverErrorCode reports a failure to resolve the server's name as Unavailable.
+// Unlike in neterr, a missing host isn't NotFound from the perspective of an RPC.
+func resolverErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*net.DNSError](err); ok {
+		if e.IsTimeout {
+			return codes.DeadlineExceeded
+		}
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
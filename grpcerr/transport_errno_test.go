@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !plan9
+
+package grpcerr
+
+import (
+	"net"
+	"syscall"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestTransportErrorCodeErrno(t *testing.T) {
+	err := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	if got := TransportErrorCode(err); got != codes.Unavailable {
+		t.Errorf("got %v; want %v", got, codes.Unavailable)
+	}
+}
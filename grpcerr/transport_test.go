@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransportErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"nil", nil, codes.OK},
+		{"dial deadline", fmt.Errorf("dial: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"eof", io.EOF, codes.Unavailable},
+		{"unexpected eof", fmt.Errorf("read: %w", io.ErrUnexpectedEOF), codes.Unavailable},
+		{"name resolution", &net.DNSError{Name: "example.invalid", IsNotFound: true}, codes.Unavailable},
+		{"name resolution timeout", &net.DNSError{Name: "example.invalid", IsTimeout: true}, codes.DeadlineExceeded},
+		{"transport closing", status.Error(codes.Unknown, "transport is closing"), codes.Unavailable},
+		{"goaway", errors.New("rpc error: received prior goaway: code: NO_ERROR"), codes.Unavailable},
+		{"unknown", errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransportErrorCode(tt.err); got != tt.code {
+				t.Errorf("got %v; want %v", got, tt.code)
+			}
+		})
+	}
+}
+
+func TestTransportErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, TransportErrorCoder(), []errcodetest.Case{
+		{Name: "unexpected eof", Err: io.ErrUnexpectedEOF, Want: codes.Unavailable},
+		{Name: "deadline exceeded", Err: context.DeadlineExceeded, Want: codes.DeadlineExceeded},
+		{Name: "dns", Err: &net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}, Want: codes.Unavailable},
+		{Name: "transport closing", Err: errors.New("transport is closing"), Want: codes.Unavailable},
+	})
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcmw
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"bursavich.dev/errcode/grpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// A clientError is a gRPC status error that implements errcode.Error.
+type clientError struct {
+	s   *status.Status
+	err error
+}
+
+func (e *clientError) Code() codes.Code           { return e.s.Code() }
+func (e *clientError) GRPCStatus() *status.Status { return e.s }
+func (e *clientError) Error() string              { return e.err.Error() }
+func (e *clientError) Unwrap() error              { return e.err }
+
+func (e *clientError) RetryDelay() (time.Duration, bool) { return grpcerr.StatusRetryDelay(e.s) }
+
+func clientConvert(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok || s.Code() == codes.Unknown {
+		if code := grpcerr.TransportErrorCode(err); code != codes.Unknown {
+			s = status.New(code, err.Error())
+		}
+	}
+	return &clientError{s: s, err: err}
+}
+
+// UnaryClientInterceptor returns a unary client interceptor that wraps
+// returned errors so that they implement both errcode.Error and the
+// grpcerr.Error interface.
+//
+// Errors that don't have a gRPC status, or have an Unknown status, are
+// classified by grpcerr.TransportErrorCoder when possible: refused connections
+// and name resolution failures are Unavailable, and context errors keep
+// their corresponding codes.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return clientConvert(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientInterceptor returns a stream client interceptor that wraps
+// errors like UnaryClientInterceptor. The io.EOF errors that signal the
+// end of a stream are returned unchanged.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, clientConvert(err)
+		}
+		return &clientStream{cs}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+}
+
+func (cs *clientStream) Header() (metadata.MD, error) {
+	md, err := cs.ClientStream.Header()
+	return md, clientConvert(err)
+}
+
+func (cs *clientStream) CloseSend() error {
+	return clientConvert(cs.ClientStream.CloseSend())
+}
+
+func (cs *clientStream) SendMsg(m any) error {
+	return streamConvert(cs.ClientStream.SendMsg(m))
+}
+
+func (cs *clientStream) RecvMsg(m any) error {
+	return streamConvert(cs.ClientStream.RecvMsg(m))
+}
+
+func streamConvert(err error) error {
+	if err == io.EOF {
+		return err
+	}
+	return clientConvert(err)
+}
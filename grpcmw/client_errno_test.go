@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !plan9
+
+package grpcmw
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryClientInterceptorErrno(t *testing.T) {
+	connErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return connErr
+	}
+	err := UnaryClientInterceptor()(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+	if got := status.Code(err); got != codes.Unavailable {
+		t.Errorf("status.Code: got %v; want %v", got, codes.Unavailable)
+	}
+	if !errors.Is(err, connErr) {
+		t.Errorf("error doesn't wrap %v", connErr)
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcmw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "status",
+			err:  status.Error(codes.NotFound, "missing"),
+			code: codes.NotFound,
+		},
+		{
+			name: "name resolution",
+			err:  fmt.Errorf("resolve: %w", &net.DNSError{Name: "example.invalid", IsNotFound: true}),
+			code: codes.Unavailable,
+		},
+		{
+			name: "context",
+			err:  context.Canceled,
+			code: codes.Canceled,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			code: codes.Unknown,
+		},
+	}
+	interceptor := UnaryClientInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+				return tt.err
+			}
+			err := interceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+			var e errcode.Error
+			if !errors.As(err, &e) {
+				t.Fatalf("error doesn't implement errcode.Error: %v", err)
+			}
+			if got := e.Code(); got != tt.code {
+				t.Errorf("Code: got %v; want %v", got, tt.code)
+			}
+			if got := status.Code(err); got != tt.code {
+				t.Errorf("status.Code: got %v; want %v", got, tt.code)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error doesn't wrap %v", tt.err)
+			}
+		})
+	}
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error { return nil }
+	if err := interceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+type testClientStream struct {
+	grpc.ClientStream
+	err error
+}
+
+func (cs testClientStream) RecvMsg(any) error { return cs.err }
+
+func TestStreamClientInterceptor(t *testing.T) {
+	interceptor := StreamClientInterceptor()
+	for _, tt := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: io.EOF},
+		{err: status.Error(codes.ResourceExhausted, "slow down"), code: codes.ResourceExhausted},
+	} {
+		streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			return testClientStream{err: tt.err}, nil
+		}
+		cs, err := interceptor(context.Background(), &grpc.StreamDesc{}, nil, "/svc/Method", streamer)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		err = cs.RecvMsg(nil)
+		if tt.err == io.EOF {
+			if err != io.EOF {
+				t.Errorf("RecvMsg: got %v; want io.EOF", err)
+			}
+			continue
+		}
+		if got := errcode.CodedErrorCoder().ErrorCode(err); got != tt.code {
+			t.Errorf("RecvMsg: got code %v; want %v", got, tt.code)
+		}
+	}
+}
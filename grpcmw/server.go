@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package grpcmw provides gRPC interceptors that resolve the codes of errors
+// returned by servers and clients.
+package grpcmw
+
+import (
+	"context"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// An Option configures an interceptor.
+type Option func(*options)
+
+type options struct {
+	messageFn func(codes.Code, error) string
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		messageFn: func(_ codes.Code, err error) string { return err.Error() },
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithMessageFunc returns an Option that sets the function used to build the
+// status message of an error converted by a server interceptor. By default,
+// the message is the error's message, unless it has a public message, which
+// is always sent instead. Unlike httpmw, messages aren't sanitized by default;
+// grpcerr.SanitizeMessage may be used to do so.
+func WithMessageFunc(fn func(code codes.Code, err error) string) Option {
+	return func(o *options) { o.messageFn = fn }
+}
+
+type serverCoder struct {
+	coder errcode.ErrorCoder
+	opts  *options
+}
+
+func newServerCoder(coder errcode.ErrorCoder, opts []Option) *serverCoder {
+	return &serverCoder{
+		coder: errcode.Compact(grpcerr.ErrorCoder(), coder),
+		opts:  newOptions(opts),
+	}
+}
+
+func (s *serverCoder) convert(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg, public := errcode.PublicMessage(err)
+	if _, ok := errcode.As[grpcerr.Error](err); ok {
+		_, _, hasReason := errcode.Reason(err)
+		_, hasRequestID := errcode.RequestID(err)
+		if _, ok := err.(grpcerr.Error); ok && !public && !hasReason && !hasRequestID {
+			return err
+		}
+		// Wrapped statuses keep their code, message, and details.
+		return grpcerr.From(err).Err()
+	}
+	code := errcode.ResolveError(s.coder, err)
+	if !public {
+		msg = s.opts.messageFn(code, err)
+	}
+	return grpcerr.WithErrorDetails(status.New(code, msg), err).Err()
+}
+
+// UnaryServerInterceptor returns a unary server interceptor that converts
+// errors returned by handlers into status errors with the code resolved by
+// the given ErrorCoder. Errors that already have a gRPC status are returned
+// unchanged, and wrapped status errors keep their status code.
+//
+// The ErrorCoder is installed in the handler's context and may be retrieved
+// with errcode.FromContext.
+func UnaryServerInterceptor(coder errcode.ErrorCoder, opts ...Option) grpc.UnaryServerInterceptor {
+	s := newServerCoder(coder, opts)
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(errcode.NewContext(ctx, coder), req)
+		return resp, s.convert(err)
+	}
+}
+
+// StreamServerInterceptor returns a stream server interceptor that converts
+// errors returned by handlers into status errors with the code resolved by
+// the given ErrorCoder. Errors that already have a gRPC status are returned
+// unchanged, and wrapped status errors keep their status code.
+//
+// The ErrorCoder is installed in the stream's context and may be retrieved
+// with errcode.FromContext.
+func StreamServerInterceptor(coder errcode.ErrorCoder, opts ...Option) grpc.StreamServerInterceptor {
+	s := newServerCoder(coder, opts)
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ss = &serverStream{ServerStream: ss, ctx: errcode.NewContext(ss.Context(), coder)}
+		return s.convert(handler(srv, ss))
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStream) Context() context.Context { return ss.ctx }
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package grpcmw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	coder := errcode.FileSystemErrorCoder()
+	sanitize := WithMessageFunc(func(code codes.Code, err error) string {
+		if code == codes.Unknown {
+			return "internal error"
+		}
+		return err.Error()
+	})
+	tests := []struct {
+		name      string
+		err       error
+		code      codes.Code
+		msg       string
+		reason    string
+		requestID string
+	}{
+		{name: "nil"},
+		{
+			name: "coded",
+			err:  fmt.Errorf("open: %w", fs.ErrNotExist),
+			code: codes.NotFound,
+			msg:  "open: file does not exist",
+		},
+		{
+			name: "status",
+			err:  status.Error(codes.Aborted, "conflict"),
+			code: codes.Aborted,
+			msg:  "conflict",
+		},
+		{
+			name: "wrapped status",
+			err:  fmt.Errorf("update: %w", status.Error(codes.Aborted, "conflict")),
+			code: codes.Aborted,
+			msg:  "update: rpc error: code = Aborted desc = conflict",
+		},
+		{
+			name:   "wrapped status details",
+			err:    fmt.Errorf("update: %w", withErrorInfo(status.New(codes.Aborted, "conflict"), "ROW_LOCKED").Err()),
+			code:   codes.Aborted,
+			msg:    "update: rpc error: code = Aborted desc = conflict",
+			reason: "ROW_LOCKED",
+		},
+		{
+			name: "sanitized",
+			err:  errors.New("secret"),
+			code: codes.Unknown,
+			msg:  "internal error",
+		},
+		{
+			name: "public",
+			err:  errcode.WithPublicMessage(errors.New("secret"), "try again later"),
+			code: codes.Unknown,
+			msg:  "try again later",
+		},
+		{
+			name: "public status",
+			err:  grpcerr.New(status.New(codes.Aborted, "conflict on row 7"), errcode.WithPublicMessage(errors.New("secret"), "conflict")),
+			code: codes.Aborted,
+			msg:  "conflict",
+		},
+		{
+			name:   "reason",
+			err:    errcode.WithReason(fmt.Errorf("open: %w", fs.ErrNotExist), "example.com", "FILE_NOT_FOUND"),
+			code:   codes.NotFound,
+			msg:    "open: file does not exist",
+			reason: "FILE_NOT_FOUND",
+		},
+		{
+			name:   "reason status",
+			err:    grpcerr.New(status.New(codes.Aborted, "conflict"), errcode.WithReason(errors.New("conflict"), "example.com", "ROW_LOCKED")),
+			code:   codes.Aborted,
+			msg:    "conflict",
+			reason: "ROW_LOCKED",
+		},
+		{
+			name:      "request id",
+			err:       errcode.WithRequestID(errors.New("secret"), "req-1"),
+			code:      codes.Unknown,
+			msg:       "internal error",
+			requestID: "req-1",
+		},
+		{
+			name:      "request id status",
+			err:       grpcerr.New(status.New(codes.Aborted, "conflict"), errcode.WithRequestID(errors.New("conflict"), "req-2")),
+			code:      codes.Aborted,
+			msg:       "conflict",
+			requestID: "req-2",
+		},
+		{
+			name: "cycle",
+			err:  &cycleError{},
+			code: codes.Unknown,
+			msg:  "internal error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := UnaryServerInterceptor(coder, sanitize)
+			handler := func(ctx context.Context, _ any) (any, error) {
+				if got, ok := errcode.FromContext(ctx); !ok || got != coder {
+					t.Errorf("FromContext: got %v, %v; want %v, true", got, ok, coder)
+				}
+				return nil, tt.err
+			}
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+			if tt.err == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if _, ok := err.(interface{ GRPCStatus() *status.Status }); !ok {
+				t.Fatalf("error is not a status error: %v", err)
+			}
+			s := status.Convert(err)
+			if s.Code() != tt.code {
+				t.Errorf("code: got %v; want %v", s.Code(), tt.code)
+			}
+			if s.Message() != tt.msg {
+				t.Errorf("message: got %q; want %q", s.Message(), tt.msg)
+			}
+			if got := grpcerr.ErrorInfo(err).GetReason(); got != tt.reason {
+				t.Errorf("reason: got %q; want %q", got, tt.reason)
+			}
+			if got := grpcerr.RequestInfo(err).GetRequestId(); got != tt.requestID {
+				t.Errorf("request id: got %q; want %q", got, tt.requestID)
+			}
+		})
+	}
+}
+
+// cycleError is a buggy error whose Unwrap method returns itself.
+type cycleError struct{}
+
+func (e *cycleError) Error() string { return "cycle" }
+func (e *cycleError) Unwrap() error { return e }
+
+func withErrorInfo(s *status.Status, reason string) *status.Status {
+	s, err := s.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: "example.com"})
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+}
+
+func (testServerStream) Context() context.Context { return context.Background() }
+
+func TestStreamServerInterceptor(t *testing.T) {
+	coder := errcode.ContextErrorCoder()
+	interceptor := StreamServerInterceptor(coder)
+	handler := func(_ any, ss grpc.ServerStream) error {
+		if got, ok := errcode.FromContext(ss.Context()); !ok || got != coder {
+			t.Errorf("FromContext: got %v, %v; want %v, true", got, ok, coder)
+		}
+		return fmt.Errorf("recv: %w", context.DeadlineExceeded)
+	}
+	err := interceptor(nil, testServerStream{}, &grpc.StreamServerInfo{}, handler)
+	if got := status.Code(err); got != codes.DeadlineExceeded {
+		t.Errorf("code: got %v; want %v", got, codes.DeadlineExceeded)
+	}
+}
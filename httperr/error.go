@@ -8,7 +8,6 @@
 package httperr
 
 import (
-	"errors"
 	"net/http"
 
 	"bursavich.dev/errcode"
@@ -43,18 +42,34 @@ func ErrorCoder() errcode.ErrorCoder {
 }
 
 // ErrorCode returns the gRPC code associated with the given error
-// if it implements the httperr.Error interface.
+// if it implements the httperr.Error interface. The code of a
+// *ResponseError with a problem details document that has a valid
+// "code" member, other than OK, takes precedence over its HTTP status code.
 func ErrorCode(err error) codes.Code {
+	return errorCode(err, ToGRPC)
+}
+
+func errorCode(err error, toGRPC func(int) codes.Code) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	if e, ok := err.(Error); ok || errors.As(err, &e) {
-		return ToGRPC(e.HTTPCode())
+	if e, ok := errcode.As[*ResponseError](err); ok && e.problem != nil && e.problem.Code != "" {
+		// A failed response is never OK, so such a code is ignored.
+		if code, err := errcode.ParseCode(e.problem.Code); err == nil && code != codes.OK {
+			return code
+		}
+	}
+	if e, ok := errcode.As[Error](err); ok {
+		if d, ok := e.(errcode.DualError); ok {
+			return d.Code()
+		}
+		return toGRPC(e.HTTPCode())
 	}
 	return codes.Unknown
 }
 
 // ToGRPC returns the gRPC status code associated with the given HTTP status code.
+// It returns codes.Unknown for status codes that aren't recognized.
 func ToGRPC(httpCode int) codes.Code {
 	if 200 <= httpCode && httpCode <= 299 {
 		return codes.OK
@@ -68,22 +83,117 @@ func ToGRPC(httpCode int) codes.Code {
 		return codes.PermissionDenied
 	case http.StatusNotFound: // 404
 		return codes.NotFound
-	case http.StatusConflict:
+	case http.StatusRequestTimeout: // 408
+		return codes.DeadlineExceeded
+	case http.StatusConflict: // 409
 		return codes.Aborted
+	case http.StatusPreconditionFailed: // 412
+		return codes.FailedPrecondition
+	case http.StatusRequestEntityTooLarge: // 413
+		return codes.ResourceExhausted
+	case http.StatusRequestURITooLong: // 414
+		return codes.InvalidArgument
 	case http.StatusRequestedRangeNotSatisfiable: // 416
 		return codes.OutOfRange
+	case http.StatusUnprocessableEntity: // 422
+		return codes.InvalidArgument
+	case http.StatusPreconditionRequired: // 428
+		return codes.FailedPrecondition
 	case http.StatusTooManyRequests: // 429
 		return codes.ResourceExhausted
-	case 499:
+	case http.StatusRequestHeaderFieldsTooLarge: // 431
+		return codes.InvalidArgument
+	case http.StatusUnavailableForLegalReasons: // 451
+		return codes.PermissionDenied
+	case 499: // Client Closed Request
 		return codes.Canceled
 	case http.StatusInternalServerError: // 500
 		return codes.Internal
 	case http.StatusNotImplemented: // 501
 		return codes.Unimplemented
-	case http.StatusServiceUnavailable: //503
+	case http.StatusBadGateway: // 502
 		return codes.Unavailable
-	case http.StatusBadGateway: // 504
+	case http.StatusServiceUnavailable: // 503
+		return codes.Unavailable
+	case http.StatusGatewayTimeout: // 504
 		return codes.DeadlineExceeded
 	}
 	return codes.Unknown
 }
+
+// Options configures the mapping of HTTP status codes to gRPC status codes.
+// The zero value is equivalent to ToGRPC.
+type Options struct {
+	// ClientErrorCode is the code for 4xx status codes that aren't otherwise recognized.
+	// If it's OK, codes.Unknown is used.
+	ClientErrorCode codes.Code
+
+	// ServerErrorCode is the code for 5xx status codes that aren't otherwise recognized.
+	// If it's OK, codes.Unknown is used.
+	ServerErrorCode codes.Code
+}
+
+// ToGRPC returns the gRPC status code associated with the given HTTP status code.
+func (o *Options) ToGRPC(httpCode int) codes.Code {
+	if code := ToGRPC(httpCode); code != codes.Unknown {
+		return code
+	}
+	switch {
+	case 400 <= httpCode && httpCode <= 499 && o.ClientErrorCode != codes.OK:
+		return o.ClientErrorCode
+	case 500 <= httpCode && httpCode <= 599 && o.ServerErrorCode != codes.OK:
+		return o.ServerErrorCode
+	}
+	return codes.Unknown
+}
+
+// ErrorCoder returns an ErrorCoder that handles errors like ErrorCode,
+// but uses the options' mapping of HTTP status codes.
+func (o *Options) ErrorCoder() errcode.ErrorCoder {
+	opts := *o
+	return errcode.FromFunc(func(err error) codes.Code {
+		return errorCode(err, opts.ToGRPC)
+	})
+}
+
+// Status returns the HTTP status code of an error whose gRPC code was resolved
+// as the given code. If an errcode.DualError in err's chain has the same code,
+// its explicit HTTP status code is returned. Otherwise, FromGRPC(code) is returned.
+func Status(code codes.Code, err error) int {
+	if e, ok := errcode.As[errcode.DualError](err); ok && e.Code() == code {
+		return e.HTTPCode()
+	}
+	return FromGRPC(code)
+}
+
+// FromGRPC returns the HTTP status code associated with the given gRPC status code.
+// It uses the same mapping as gRPC-Gateway and returns 500 for unrecognized codes.
+func FromGRPC(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK // 200
+	case codes.Canceled:
+		return 499 // Client Closed Request
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest // 400
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized // 401
+	case codes.PermissionDenied:
+		return http.StatusForbidden // 403
+	case codes.NotFound:
+		return http.StatusNotFound // 404
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict // 409
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests // 429
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		return http.StatusInternalServerError // 500
+	case codes.Unimplemented:
+		return http.StatusNotImplemented // 501
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable // 503
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout // 504
+	}
+	return http.StatusInternalServerError
+}
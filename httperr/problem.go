@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"bursavich.dev/errcode"
+)
+
+// ProblemContentType is the media type of an RFC 7807 problem details document.
+const ProblemContentType = "application/problem+json"
+
+// SEE: https://www.rfc-editor.org/rfc/rfc7807
+
+// A Problem is an RFC 7807 problem details document.
+type Problem struct {
+	// Type is a URI reference that identifies the problem type.
+	// When it's empty, it's assumed to be "about:blank".
+	Type string `json:"type,omitempty"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title,omitempty"`
+	// Status is the HTTP status code.
+	Status int `json:"status,omitempty"`
+	// Detail is a human-readable explanation of this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference that identifies this occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// Code is an extension member with the canonical name of the gRPC code,
+	// such as "NOT_FOUND".
+	Code string `json:"code,omitempty"`
+	// Reason is an extension member with the error's machine-readable reason.
+	Reason string `json:"reason,omitempty"`
+	// Domain is an extension member with the logical grouping to which
+	// the reason belongs.
+	Domain string `json:"domain,omitempty"`
+	// RequestID is an extension member with the ID of the request that caused
+	// the error.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// WriteProblem writes the error as a problem details document, with the HTTP
+// status derived from the code resolved by the given ErrorCoder, as by Status.
+// The document's detail is the error's public message, if it has one, or else
+// its message, and its reason, domain, and request ID are the error's, if it
+// has them.
+func WriteProblem(w http.ResponseWriter, err error, coder errcode.ErrorCoder) {
+	code := errcode.ResolveError(coder, err)
+	status := Status(code, err)
+	p := &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   errcode.CodeString(code),
+	}
+	p.Domain, p.Reason, _ = errcode.Reason(err)
+	p.RequestID, _ = errcode.RequestID(err)
+	if msg, ok := errcode.PublicMessage(err); ok {
+		p.Detail = msg
+	} else if err != nil {
+		p.Detail = err.Error()
+	}
+	b, _ := json.Marshal(p)
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+// isProblem reports whether the header's content type is a problem details document.
+func isProblem(h http.Header) bool {
+	mt, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	return err == nil && mt == ProblemContentType
+}
+
+// parseProblem returns the problem details document in the body, if it's valid.
+func parseProblem(body []byte) *Problem {
+	var p Problem
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil
+	}
+	return &p
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+func TestProblem(t *testing.T) {
+	w := httptest.NewRecorder()
+	WriteProblem(w, fs.ErrExist, errcode.FileSystemErrorCoder())
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if got := resp.StatusCode; got != http.StatusConflict {
+		t.Errorf("status: got %d; want %d", got, http.StatusConflict)
+	}
+	err := FromResponse(resp)
+	var re *ResponseError
+	if !errors.As(err, &re) || re.Problem() == nil {
+		t.Fatalf("FromResponse: got %v; want a problem", err)
+	}
+	want := Problem{
+		Type:   "about:blank",
+		Title:  "Conflict",
+		Status: http.StatusConflict,
+		Detail: "file already exists",
+		Code:   "ALREADY_EXISTS",
+	}
+	if got := *re.Problem(); got != want {
+		t.Errorf("Problem: got %+v; want %+v", got, want)
+	}
+	// The problem's code is more specific than the HTTP status code.
+	if got := ErrorCode(err); got != codes.AlreadyExists {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.AlreadyExists)
+	}
+	opts := &Options{ClientErrorCode: codes.InvalidArgument}
+	if got := opts.ErrorCoder().ErrorCode(err); got != codes.AlreadyExists {
+		t.Errorf("Options.ErrorCoder: got %v; want %v", got, codes.AlreadyExists)
+	}
+	if got, want := err.Error(), "httperr: 409 Conflict: file already exists"; got != want {
+		t.Errorf("Error: got %q; want %q", got, want)
+	}
+}
+
+func TestProblemPublicMessage(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := errcode.WithPublicMessage(fmt.Errorf("open /etc/app: %w", fs.ErrExist), "already exists")
+	WriteProblem(w, err, errcode.FileSystemErrorCoder())
+
+	var p Problem
+	if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Detail != "already exists" {
+		t.Errorf("Detail: got %q; want %q", p.Detail, "already exists")
+	}
+}
+
+func TestProblemReason(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := errcode.WithReason(fmt.Errorf("open /etc/app: %w", fs.ErrExist), "example.com", "CONFIG_EXISTS")
+	WriteProblem(w, errcode.WithRequestID(err, "req-1"), errcode.FileSystemErrorCoder())
+
+	p := parseProblem(w.Body.Bytes())
+	if p == nil || p.Reason != "CONFIG_EXISTS" || p.Domain != "example.com" || p.RequestID != "req-1" {
+		t.Errorf("Problem: got %+v", p)
+	}
+}
+
+func TestProblemCodeOK(t *testing.T) {
+	// A failed response whose problem claims success falls back to its status.
+	err := &ResponseError{status: http.StatusInternalServerError, problem: &Problem{Code: "OK"}}
+	if got := ErrorCode(err); got != codes.Internal {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.Internal)
+	}
+	opts := &Options{ServerErrorCode: codes.Unavailable}
+	if got := opts.ErrorCoder().ErrorCode(err); got != codes.Internal {
+		t.Errorf("Options.ErrorCoder: got %v; want %v", got, codes.Internal)
+	}
+}
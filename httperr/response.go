@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// maxBodySnippet is the maximum number of body bytes captured by FromResponse.
+	maxBodySnippet = 1 << 10
+	// maxProblem is the maximum size of a problem details document read by FromResponse.
+	maxProblem = 64 << 10
+)
+
+// A ResponseError is an error that describes an unsuccessful HTTP response.
+// It implements the Error interface.
+type ResponseError struct {
+	status  int
+	method  string
+	url     string
+	header  http.Header
+	body    []byte
+	problem *Problem
+}
+
+// FromResponse returns a *ResponseError describing the response if its status
+// code isn't successful, or nil otherwise.
+//
+// The error captures the request's method and redacted URL, the response's
+// headers except Set-Cookie, and a snippet of the body. Up to 1 KiB of the body
+// is read, but the body isn't closed.
+//
+// If the body is an RFC 7807 problem details document, up to 64 KiB is read
+// and the document is available from the error's Problem method.
+func FromResponse(resp *http.Response) error {
+	if 200 <= resp.StatusCode && resp.StatusCode <= 299 {
+		return nil
+	}
+	e := &ResponseError{status: resp.StatusCode}
+	if req := resp.Request; req != nil {
+		e.method = req.Method
+		if req.URL != nil {
+			e.url = req.URL.Redacted()
+		}
+	}
+	e.header = resp.Header.Clone()
+	if e.header == nil {
+		e.header = make(http.Header)
+	}
+	e.header.Del("Set-Cookie")
+	if resp.Body == nil {
+		return e
+	}
+	if isProblem(e.header) {
+		e.body, _ = io.ReadAll(io.LimitReader(resp.Body, maxProblem))
+		e.problem = parseProblem(e.body)
+		return e
+	}
+	e.body, _ = io.ReadAll(io.LimitReader(resp.Body, maxBodySnippet))
+	return e
+}
+
+// HTTPCode returns the response's status code.
+func (e *ResponseError) HTTPCode() int { return e.status }
+
+// Method returns the request's method, if it's known.
+func (e *ResponseError) Method() string { return e.method }
+
+// URL returns the request's URL with any password redacted, if it's known.
+func (e *ResponseError) URL() string { return e.url }
+
+// Header returns the response's headers, except Set-Cookie.
+func (e *ResponseError) Header() http.Header { return e.header }
+
+// Body returns the captured prefix of the response's body.
+func (e *ResponseError) Body() []byte { return e.body }
+
+// Problem returns the response's problem details document, if it has one.
+func (e *ResponseError) Problem() *Problem { return e.problem }
+
+func (e *ResponseError) Error() string {
+	var b strings.Builder
+	b.WriteString("httperr: ")
+	if e.method != "" || e.url != "" {
+		fmt.Fprintf(&b, "%s %s: ", e.method, e.url)
+	}
+	b.WriteString(strings.TrimSpace(fmt.Sprintf("%d %s", e.status, http.StatusText(e.status))))
+	if p := e.problem; p != nil {
+		if p.Title != "" && p.Title != http.StatusText(e.status) {
+			b.WriteString(": ")
+			b.WriteString(p.Title)
+		}
+		if p.Detail != "" {
+			b.WriteString(": ")
+			b.WriteString(p.Detail)
+		}
+		return b.String()
+	}
+	if body := snippet(e.body); body != "" {
+		b.WriteString(": ")
+		b.WriteString(body)
+	}
+	return b.String()
+}
+
+// snippet returns the body as a single line of valid UTF-8 text.
+func snippet(body []byte) string {
+	s := strings.ToValidUTF8(string(body), "")
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"bursavich.dev/errcode"
+)
+
+// RetryAfter returns the delay from the Retry-After header of the first
+// *ResponseError in err's chain and reports whether it has a valid header.
+// The header may be a number of seconds or an HTTP-date.
+func RetryAfter(err error) (time.Duration, bool) {
+	e, ok := errcode.As[*ResponseError](err)
+	if !ok {
+		return 0, false
+	}
+	return e.RetryDelay()
+}
+
+// RetryDelay returns the delay from the response's Retry-After header
+// and reports whether it's valid. It implements errcode.RetryDelayError.
+func (e *ResponseError) RetryDelay() (time.Duration, bool) {
+	return parseRetryAfter(e.header.Get("Retry-After"), time.Now())
+}
+
+// SEE: https://www.rfc-editor.org/rfc/rfc9110#field.retry-after
+
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.ParseUint(v, 10, 32); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
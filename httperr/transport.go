@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/neterr"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc/codes"
+)
+
+var transportErrorCoder errcode.ErrorCoder = errcode.FromFunc(TransportErrorCode)
+
+// TransportErrorCoder returns the HTTP transport ErrorCoder.
+func TransportErrorCoder() errcode.ErrorCoder {
+	return transportErrorCoder
+}
+
+var urlCauseCoder = errcode.ErrorCoders{
+	errcode.ContextErrorCoder(),
+	neterr.ErrorCoder(),
+	errcode.TransientErrorCoder(),
+}
+
+// TransportErrorCode returns the gRPC code associated with the given error
+// if it contains an error from an HTTP client or server transport:
+//
+//   - a *url.Error, which is classified by its underlying cause
+//     (e.g. a timeout, a refused connection, or a canceled context);
+//   - an http.ErrHandlerTimeout, http.ErrServerClosed, or *http.MaxBytesError;
+//   - an http2.StreamError, http2.ConnectionError, or http2.GoAwayError
+//     from the golang.org/x/net/http2 package.
+func TransportErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	switch {
+	case errcode.Is(err, http.ErrHandlerTimeout):
+		return codes.DeadlineExceeded
+	case errcode.Is(err, http.ErrServerClosed):
+		return codes.Unavailable
+	}
+	if _, ok := errcode.As[*http.MaxBytesError](err); ok {
+		return codes.ResourceExhausted
+	}
+	if e, ok := errcode.As[*url.Error](err); ok {
+		if c := urlCauseCoder.ErrorCode(e.Err); c != codes.Unknown {
+			return c
+		}
+		if e.Timeout() {
+			return codes.DeadlineExceeded
+		}
+	}
+	if e, ok := errcode.As[http2.StreamError](err); ok {
+		return http2Code(e.Code)
+	}
+	if e, ok := errcode.As[http2.ConnectionError](err); ok {
+		return http2Code(http2.ErrCode(e))
+	}
+	if _, ok := errcode.As[http2.GoAwayError](err); ok {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// http2Code returns the gRPC code for an HTTP/2 error code,
+// using the same mapping as the gRPC HTTP/2 transport.
+func http2Code(code http2.ErrCode) codes.Code {
+	switch code {
+	case http2.ErrCodeRefusedStream:
+		return codes.Unavailable
+	case http2.ErrCodeCancel:
+		return codes.Canceled
+	case http2.ErrCodeFlowControl, http2.ErrCodeEnhanceYourCalm:
+		return codes.ResourceExhausted
+	case http2.ErrCodeInadequateSecurity:
+		return codes.PermissionDenied
+	}
+	return codes.Internal
+}
+
+// A TransportOption configures a transport returned by NewTransport.
+type TransportOption func(*transport)
+
+// WithErrorStatus returns a TransportOption that sets the function that
+// reports whether a response's status code should be converted into an error.
+// By default, status codes of 400 and above are errors.
+func WithErrorStatus(fn func(status int) bool) TransportOption {
+	return func(t *transport) { t.isError = fn }
+}
+
+// WithDrainBody returns a TransportOption that sets whether the remainder
+// of an error response's body is read before it's closed, which allows
+// the connection to be reused. It's enabled by default.
+func WithDrainBody(drain bool) TransportOption {
+	return func(t *transport) { t.drain = drain }
+}
+
+// maxDrain is the maximum number of bytes drained from an error response's body.
+const maxDrain = 64 << 10
+
+type transport struct {
+	rt      http.RoundTripper
+	isError func(int) bool
+	drain   bool
+}
+
+// NewTransport returns an http.RoundTripper that wraps rt and converts
+// error responses into errors returned by FromResponse, after closing their
+// bodies. Errors returned by rt are given the code of TransportErrorCode
+// or of the underlying network error, if it's known. If rt is nil, http.DefaultTransport is used.
+//
+// An http.Client that uses the transport returns errors that may be
+// classified by ErrorCoder and errcode.CodedErrorCoder.
+func NewTransport(rt http.RoundTripper, opts ...TransportOption) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	t := &transport{
+		rt:      rt,
+		isError: func(status int) bool { return status >= 400 },
+		drain:   true,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		code := TransportErrorCode(err)
+		if code == codes.Unknown {
+			// The error isn't wrapped in a *url.Error until it's returned by the client.
+			code = urlCauseCoder.ErrorCode(err)
+		}
+		if code != codes.Unknown {
+			err = errcode.New(code, err)
+		}
+		return nil, err
+	}
+	if !t.isError(resp.StatusCode) {
+		return resp, nil
+	}
+	err = FromResponse(resp)
+	if err == nil {
+		// A successful status code that's configured as an error.
+		err = New(resp.StatusCode, errors.New(resp.Status))
+	}
+	if t.drain {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrain))
+	}
+	resp.Body.Close()
+	return nil, err
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcodetest"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/moved":
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	coder := errcode.ErrorCoders{ErrorCoder(), errcode.CodedErrorCoder()}
+	client := &http.Client{Transport: NewTransport(srv.Client().Transport)}
+	for _, tt := range []struct {
+		path string
+		code codes.Code
+	}{
+		{path: "/ok", code: codes.OK},
+		{path: "/moved", code: codes.OK},
+		{path: "/missing", code: codes.NotFound},
+	} {
+		resp, err := client.Get(srv.URL + tt.path)
+		if err == nil {
+			resp.Body.Close()
+		}
+		if got := coder.ErrorCode(err); got != tt.code {
+			t.Errorf("GET %s: got %v; want %v: %v", tt.path, got, tt.code, err)
+		}
+	}
+
+	client = &http.Client{Transport: NewTransport(srv.Client().Transport, WithErrorStatus(func(status int) bool {
+		return status != http.StatusOK
+	}))}
+	if _, err := client.Get(srv.URL + "/moved"); coder.ErrorCode(err) != codes.Unknown || err == nil {
+		t.Errorf("GET /moved: got %v; want an error", err)
+	}
+
+	srv.Close()
+	if _, err := client.Get(srv.URL + "/ok"); coder.ErrorCode(err) != codes.Unavailable {
+		t.Errorf("GET closed server: got %v (%v); want %v", coder.ErrorCode(err), err, codes.Unavailable)
+	}
+}
+
+func TestTransportErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, TransportErrorCoder(), []errcodetest.Case{
+		{Name: "handler timeout", Err: http.ErrHandlerTimeout, Want: codes.DeadlineExceeded},
+		{Name: "server closed", Err: http.ErrServerClosed, Want: codes.Unavailable},
+		{Name: "max bytes", Err: &http.MaxBytesError{Limit: 1 << 20}, Want: codes.ResourceExhausted},
+		{Name: "url", Err: &url.Error{Op: "Get", URL: "http://example.com", Err: context.Canceled}, Want: codes.Canceled},
+		{Name: "goaway", Err: http2.GoAwayError{ErrCode: http2.ErrCodeNo}, Want: codes.Unavailable},
+	})
+}
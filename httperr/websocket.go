@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httperr
+
+import "google.golang.org/grpc/codes"
+
+// SEE: https://www.iana.org/assignments/websocket/websocket.xhtml#close-code-number
+
+var webSocketCodes = map[int]codes.Code{
+	1000: codes.OK,                 // Normal Closure
+	1001: codes.Unavailable,        // Going Away
+	1002: codes.Internal,           // Protocol Error
+	1003: codes.InvalidArgument,    // Unsupported Data
+	1006: codes.Unavailable,        // Abnormal Closure
+	1007: codes.InvalidArgument,    // Invalid Frame Payload Data
+	1008: codes.PermissionDenied,   // Policy Violation
+	1009: codes.ResourceExhausted,  // Message Too Big
+	1010: codes.FailedPrecondition, // Mandatory Extension
+	1011: codes.Internal,           // Internal Error
+	1012: codes.Unavailable,        // Service Restart
+	1013: codes.Unavailable,        // Try Again Later
+	1014: codes.Unavailable,        // Bad Gateway
+	1015: codes.Unavailable,        // TLS Handshake
+}
+
+// WebSocketToGRPC returns the gRPC status code associated with the given
+// WebSocket close code.
+//
+// Application close codes in the range 4000-4999 are interpreted by the common
+// convention of adding 4000 to an HTTP status code (e.g. 4404 is Not Found).
+// It returns codes.Unknown for close codes that aren't recognized.
+// Normal Closure is OK, so coders of close errors must replace it,
+// as by errcode.ResolveError.
+func WebSocketToGRPC(closeCode int) codes.Code {
+	if code, ok := webSocketCodes[closeCode]; ok {
+		return code
+	}
+	if 4100 <= closeCode && closeCode <= 4599 {
+		return ToGRPC(closeCode - 4000)
+	}
+	return codes.Unknown
+}
+
+// GRPCToWebSocket returns the WebSocket close code associated with the given
+// gRPC status code. Codes with a close code defined by the protocol use it, and
+// the others use an application close code derived from FromGRPC.
+func GRPCToWebSocket(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return 1000 // Normal Closure
+	case codes.InvalidArgument:
+		return 1007 // Invalid Frame Payload Data
+	case codes.PermissionDenied:
+		return 1008 // Policy Violation
+	case codes.ResourceExhausted:
+		return 1009 // Message Too Big
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		return 1011 // Internal Error
+	case codes.Unavailable:
+		return 1013 // Try Again Later
+	}
+	return 4000 + FromGRPC(code)
+}
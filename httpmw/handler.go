@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package httpmw provides net/http handlers and middleware that write
+// responses with statuses derived from the codes of errors.
+package httpmw
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime/debug"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcatalog"
+	"bursavich.dev/errcode/grpcerr"
+	"bursavich.dev/errcode/httperr"
+	"google.golang.org/grpc/codes"
+)
+
+// A HandlerFunc is an HTTP handler that returns an error.
+// It must not write a response if it returns a non-nil error.
+type HandlerFunc func(http.ResponseWriter, *http.Request) error
+
+// An Option configures a handler.
+type Option func(*options)
+
+type options struct {
+	messageFn func(codes.Code, error) string
+	logFn     func(*http.Request, codes.Code, error)
+	renderFn  func(http.ResponseWriter, *http.Request, int, *ErrorBody)
+	catalog   *errcatalog.Catalog
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		messageFn: grpcerr.SanitizeMessage,
+		logFn:     func(*http.Request, codes.Code, error) {},
+		renderFn:  renderJSON,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithMessageFunc returns an Option that sets the function used to build the
+// message of an error response. It isn't used for errors with public messages,
+// which are always sent instead.
+//
+// By default, messages are sanitized with grpcerr.SanitizeMessage, so those of
+// errors with codes that indicate server faults, such as Internal or Unknown,
+// aren't exposed to clients. The error's message may be sent for every code
+// with a function that returns err.Error().
+func WithMessageFunc(fn func(code codes.Code, err error) string) Option {
+	return func(o *options) { o.messageFn = fn }
+}
+
+// WithCatalog returns an Option that sets the catalog of localized messages.
+// The message of an error response is rendered by the catalog in the languages
+// of the request's Accept-Language header, if it has one for the error.
+// Otherwise, the public message or the message function is used.
+func WithCatalog(c *errcatalog.Catalog) Option {
+	return func(o *options) { o.catalog = c }
+}
+
+// WithErrorLog returns an Option that sets a function that is called
+// with every error that is written as a response, including recovered panics.
+func WithErrorLog(fn func(r *http.Request, code codes.Code, err error)) Option {
+	return func(o *options) { o.logFn = fn }
+}
+
+// WithRenderFunc returns an Option that sets the function used to write an
+// error response with the given status and body. By default, the body is
+// written as JSON.
+func WithRenderFunc(fn func(w http.ResponseWriter, r *http.Request, status int, body *ErrorBody)) Option {
+	return func(o *options) { o.renderFn = fn }
+}
+
+// An ErrorBody is the JSON body of an error response.
+type ErrorBody struct {
+	// Code is the canonical name of the gRPC code, such as "NOT_FOUND".
+	Code string `json:"code"`
+	// Message is a description of the error.
+	Message string `json:"message"`
+	// Reason is the error's machine-readable reason, if it has one.
+	Reason string `json:"reason,omitempty"`
+	// Domain is the logical grouping to which the reason belongs.
+	Domain string `json:"domain,omitempty"`
+	// RequestID is the ID of the request that caused the error, if it's known.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// NewErrorBody returns the body of an error response for the error with the
+// given code. Its message is the error's public message, if it has one, or
+// else the result of messageFn, which defaults to grpcerr.SanitizeMessage if
+// it's nil. Its reason, domain, and request ID are those attached to the
+// error, if any.
+func NewErrorBody(code codes.Code, err error, messageFn func(code codes.Code, err error) string) *ErrorBody {
+	msg, ok := errcode.PublicMessage(err)
+	if !ok {
+		if messageFn == nil {
+			messageFn = grpcerr.SanitizeMessage
+		}
+		msg = messageFn(code, err)
+	}
+	body := &ErrorBody{
+		Code:    errcode.CodeString(code),
+		Message: msg,
+	}
+	body.Domain, body.Reason, _ = errcode.Reason(err)
+	body.RequestID, _ = errcode.RequestID(err)
+	return body
+}
+
+// Status returns the status of an error response for the error with the given
+// code, as by httperr.Status. If the error's framework reported a non-zero
+// status that's consistent with the code, it's kept instead, because some
+// statuses, like 405, don't have a distinct code.
+func Status(code codes.Code, err error, reported int) int {
+	if reported != 0 && httperr.ToGRPC(reported) == code {
+		return reported
+	}
+	return httperr.Status(code, err)
+}
+
+type handler struct {
+	coder errcode.ErrorCoder
+	fn    HandlerFunc
+	opts  *options
+}
+
+// Handler returns an http.Handler that calls fn and writes an error response
+// for the error that it returns. The response's status is derived from the
+// code resolved by the given ErrorCoder, as by httperr.Status. Panics are
+// recovered and written as Internal errors.
+//
+// The ErrorCoder is installed in the request's context and may be retrieved
+// with errcode.FromContext.
+func Handler(coder errcode.ErrorCoder, fn HandlerFunc, opts ...Option) http.Handler {
+	return &handler{coder: coder, fn: fn, opts: newOptions(opts)}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(errcode.NewContext(r.Context(), h.coder))
+	rw, w := wrapResponseWriter(w)
+	defer recoverPanic(rw, r, h.opts)
+	if err := h.fn(w, r); err != nil {
+		h.writeError(rw, r, err)
+	}
+}
+
+func (h *handler) writeError(w *responseWriter, r *http.Request, err error) {
+	code := errcode.ResolveError(h.coder, err)
+	h.opts.logFn(r, code, err)
+	if w.wroteHeader {
+		return
+	}
+	body := NewErrorBody(code, err, h.opts.messageFn)
+	if h.opts.catalog != nil {
+		if msg, ok := h.opts.catalog.Render(err, r.Header.Get("Accept-Language")); ok {
+			body.Message = msg
+		}
+	}
+	h.opts.renderFn(w, r, httperr.Status(code, err), body)
+}
+
+type writerContextKey struct{}
+
+// Middleware returns middleware that installs the ErrorCoder and options in
+// the request's context for Error, and recovers panics like Recover. Its
+// signature is compatible with routers such as github.com/go-chi/chi.
+//
+// The ErrorCoder may be retrieved with errcode.FromContext.
+func Middleware(coder errcode.ErrorCoder, opts ...Option) func(http.Handler) http.Handler {
+	h := &handler{coder: coder, opts: newOptions(opts)}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := errcode.NewContext(r.Context(), coder)
+			r = r.WithContext(context.WithValue(ctx, writerContextKey{}, h))
+			rw, w := wrapResponseWriter(w)
+			defer recoverPanic(rw, r, h.opts)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Error writes an error response for the error using the ErrorCoder and
+// options installed by Middleware. Without Middleware, it uses the ErrorCoder
+// installed by errcode.FromContext, if any, and the default options.
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	h, ok := r.Context().Value(writerContextKey{}).(*handler)
+	if !ok {
+		h = &handler{coder: errcode.CodedErrorCoder(), opts: newOptions(nil)}
+		if coder, ok := errcode.FromContext(r.Context()); ok {
+			h.coder = coder
+		}
+	}
+	var rw *responseWriter
+	if v, ok := w.(interface{ recorder() *responseWriter }); ok {
+		rw = v.recorder()
+	} else {
+		rw = &responseWriter{ResponseWriter: w}
+	}
+	h.writeError(rw, r, err)
+}
+
+// Recover returns middleware that recovers panics and writes them as Internal
+// error responses, unless a response has already been started.
+// A panic with http.ErrAbortHandler is propagated to abort the response.
+func Recover(opts ...Option) func(http.Handler) http.Handler {
+	o := newOptions(opts)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw, w := wrapResponseWriter(w)
+			defer recoverPanic(rw, r, o)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func recoverPanic(w *responseWriter, r *http.Request, o *options) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	if v == http.ErrAbortHandler {
+		panic(v)
+	}
+	err := fmt.Errorf("httpmw: panic serving %s: %v\n%s", r.URL.Path, v, debug.Stack())
+	o.logFn(r, codes.Internal, err)
+	if !w.wroteHeader {
+		o.renderFn(w, r, http.StatusInternalServerError, &ErrorBody{
+			Code:    errcode.CodeString(codes.Internal),
+			Message: http.StatusText(http.StatusInternalServerError),
+		})
+	}
+}
+
+func renderJSON(w http.ResponseWriter, _ *http.Request, status int, body *ErrorBody) {
+	b, _ := json.Marshal(body)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+// A responseWriter records whether a response has been started.
+type responseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+// wrapResponseWriter returns a responseWriter for w and the ResponseWriter to
+// pass to handlers, which also implements the optional http.Flusher and
+// http.Hijacker interfaces if w does.
+func wrapResponseWriter(w http.ResponseWriter) (*responseWriter, http.ResponseWriter) {
+	rw := &responseWriter{ResponseWriter: w}
+	_, flusher := w.(http.Flusher)
+	_, hijacker := w.(http.Hijacker)
+	switch {
+	case flusher && hijacker:
+		return rw, flushHijacker{rw}
+	case flusher:
+		return rw, flusherWriter{rw}
+	case hijacker:
+		return rw, hijackerWriter{rw}
+	default:
+		return rw, rw
+	}
+}
+
+func (w *responseWriter) recorder() *responseWriter {
+	return w
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) flush() {
+	w.wroteHeader = true
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+func (w *responseWriter) hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, buf, err := w.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		// The connection belongs to the handler, so no response may be written.
+		w.wroteHeader = true
+	}
+	return conn, buf, err
+}
+
+type flusherWriter struct{ *responseWriter }
+
+func (w flusherWriter) Flush() { w.flush() }
+
+type hijackerWriter struct{ *responseWriter }
+
+func (w hijackerWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) { return w.hijack() }
+
+type flushHijacker struct{ *responseWriter }
+
+func (w flushHijacker) Flush() { w.flush() }
+
+func (w flushHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) { return w.hijack() }
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package httpmw
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcatalog"
+	"google.golang.org/grpc/codes"
+)
+
+func TestHandler(t *testing.T) {
+	coder := errcode.FromFunc(errcode.Compact(errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder()).ErrorCode)
+	tests := []struct {
+		name   string
+		fn     HandlerFunc
+		status int
+		body   ErrorBody
+	}{
+		{
+			name: "ok",
+			fn: func(w http.ResponseWriter, r *http.Request) error {
+				if got, ok := errcode.FromContext(r.Context()); !ok || got != coder {
+					t.Errorf("FromContext: got %v, %v; want %v, true", got, ok, coder)
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return nil
+			},
+			status: http.StatusNoContent,
+		},
+		{
+			name: "coded",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				return fmt.Errorf("open: %w", fs.ErrNotExist)
+			},
+			status: http.StatusNotFound,
+			body:   ErrorBody{Code: "NOT_FOUND", Message: "open: file does not exist"},
+		},
+		{
+			name: "dual",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				return errcode.NewDual(codes.Unavailable, http.StatusTooManyRequests, errors.New("busy"))
+			},
+			status: http.StatusTooManyRequests,
+			body:   ErrorBody{Code: "UNAVAILABLE", Message: "service unavailable"},
+		},
+		{
+			name: "public",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				return errcode.WithPublicMessage(fmt.Errorf("open /etc/app: %w", fs.ErrPermission), "access denied")
+			},
+			status: http.StatusForbidden,
+			body:   ErrorBody{Code: "PERMISSION_DENIED", Message: "access denied"},
+		},
+		{
+			name: "reason",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				return errcode.WithReason(fmt.Errorf("open: %w", fs.ErrNotExist), "example.com", "FILE_NOT_FOUND")
+			},
+			status: http.StatusNotFound,
+			body:   ErrorBody{Code: "NOT_FOUND", Message: "open: file does not exist", Reason: "FILE_NOT_FOUND", Domain: "example.com"},
+		},
+		{
+			name: "request id",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				return errcode.WithRequestID(errors.New("boom"), "req-1")
+			},
+			status: http.StatusInternalServerError,
+			body:   ErrorBody{Code: "UNKNOWN", Message: "unknown error", RequestID: "req-1"},
+		},
+		{
+			name: "unknown",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				return errors.New("boom")
+			},
+			status: http.StatusInternalServerError,
+			body:   ErrorBody{Code: "UNKNOWN", Message: "unknown error"},
+		},
+		{
+			name: "panic",
+			fn: func(http.ResponseWriter, *http.Request) error {
+				panic("boom")
+			},
+			status: http.StatusInternalServerError,
+			body:   ErrorBody{Code: "INTERNAL", Message: "Internal Server Error"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logged codes.Code
+			h := Handler(coder, tt.fn, WithErrorLog(func(_ *http.Request, code codes.Code, _ error) {
+				logged = code
+			}))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			if w.Code != tt.status {
+				t.Errorf("status: got %d; want %d", w.Code, tt.status)
+			}
+			if tt.body == (ErrorBody{}) {
+				return
+			}
+			var body ErrorBody
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid body %q: %v", w.Body, err)
+			}
+			if body != tt.body {
+				t.Errorf("body: got %+v; want %+v", body, tt.body)
+			}
+			if want, _ := errcode.ParseCode(tt.body.Code); logged != want {
+				t.Errorf("logged code: got %v; want %v", logged, want)
+			}
+		})
+	}
+}
+
+func TestRecover(t *testing.T) {
+	h := Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		panic("boom")
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Code != http.StatusAccepted {
+		t.Errorf("status: got %d; want %d", w.Code, http.StatusAccepted)
+	}
+	if w.Body.Len() != 0 {
+		t.Errorf("unexpected body: %q", w.Body)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var status int
+	var body *ErrorBody
+	mw := Middleware(errcode.ContextErrorCoder(), WithRenderFunc(func(w http.ResponseWriter, _ *http.Request, s int, b *ErrorBody) {
+		status, body = s, b
+		w.WriteHeader(s)
+	}))
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Error(w, r, fmt.Errorf("query: %w", context.DeadlineExceeded))
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Code != http.StatusGatewayTimeout || status != http.StatusGatewayTimeout {
+		t.Errorf("status: got %d (rendered %d); want %d", w.Code, status, http.StatusGatewayTimeout)
+	}
+	want := &ErrorBody{Code: "DEADLINE_EXCEEDED", Message: "query: context deadline exceeded"}
+	if body == nil || *body != *want {
+		t.Errorf("body: got %+v; want %+v", body, want)
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	coder := errcode.Compact(errcode.CodedErrorCoder(), errcode.FileSystemErrorCoder())
+	c := errcatalog.New(coder, "en")
+	if err := c.Add("en", codes.NotFound, "", "Not found."); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("fr", codes.NotFound, "", "Introuvable."); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		lang string
+		err  error
+		want string
+	}{
+		{"", fmt.Errorf("open: %w", fs.ErrNotExist), "Not found."},
+		{"fr-CA, en;q=0.5", fmt.Errorf("open: %w", fs.ErrNotExist), "Introuvable."},
+		{"fr", errcode.WithPublicMessage(fmt.Errorf("open: %w", fs.ErrNotExist), "gone"), "Introuvable."},
+		{"fr", errcode.WithPublicMessage(fmt.Errorf("open: %w", fs.ErrPermission), "access denied"), "access denied"},
+		{"fr", errors.New("boom"), "unknown error"},
+	}
+	for _, tt := range tests {
+		h := Handler(errcode.FromFunc(coder.ErrorCode), func(http.ResponseWriter, *http.Request) error {
+			return tt.err
+		}, WithCatalog(c))
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tt.lang != "" {
+			r.Header.Set("Accept-Language", tt.lang)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		var body ErrorBody
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("invalid body %q: %v", w.Body, err)
+		}
+		if body.Message != tt.want {
+			t.Errorf("message of %v in %q: got %q; want %q", tt.err, tt.lang, body.Message, tt.want)
+		}
+	}
+}
+
+type plainWriter struct{ http.ResponseWriter }
+
+type hijackRecorder struct {
+	*httptest.ResponseRecorder
+	hijacked bool
+}
+
+func (w *hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.hijacked = true
+	return nil, nil, nil
+}
+
+func TestResponseWriterInterfaces(t *testing.T) {
+	tests := []struct {
+		name     string
+		w        http.ResponseWriter
+		flusher  bool
+		hijacker bool
+	}{
+		{"plain", plainWriter{httptest.NewRecorder()}, false, false},
+		{"flusher", httptest.NewRecorder(), true, false},
+		{"hijacker", plainWriter{&hijackRecorder{ResponseRecorder: httptest.NewRecorder()}}, false, false},
+		{"both", &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}, true, true},
+	}
+	for _, tt := range tests {
+		h := Handler(errcode.CodedErrorCoder(), func(w http.ResponseWriter, _ *http.Request) error {
+			if _, ok := w.(http.Flusher); ok != tt.flusher {
+				t.Errorf("%s: Flusher: got %v; want %v", tt.name, ok, tt.flusher)
+			}
+			if _, ok := w.(http.Hijacker); ok != tt.hijacker {
+				t.Errorf("%s: Hijacker: got %v; want %v", tt.name, ok, tt.hijacker)
+			}
+			return nil
+		})
+		h.ServeHTTP(tt.w, httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+}
+
+func TestFlushHijack(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(http.ResponseWriter)
+	}{
+		{"flush", func(w http.ResponseWriter) { w.(http.Flusher).Flush() }},
+		{"hijack", func(w http.ResponseWriter) { _, _, _ = w.(http.Hijacker).Hijack() }},
+	}
+	for _, tt := range tests {
+		w := &hijackRecorder{ResponseRecorder: httptest.NewRecorder()}
+		h := Handler(errcode.CodedErrorCoder(), func(w http.ResponseWriter, _ *http.Request) error {
+			tt.fn(w)
+			return errcode.New(codes.Internal, errors.New("boom"))
+		})
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		if w.Body.Len() != 0 {
+			t.Errorf("%s: error written after response started: %q", tt.name, w.Body)
+		}
+		if tt.name == "hijack" && !w.hijacked {
+			t.Errorf("%s: connection not hijacked", tt.name)
+		}
+	}
+}
+
+func TestNewErrorBody(t *testing.T) {
+	redact := func(codes.Code, error) string { return "redacted" }
+	tests := []struct {
+		name string
+		err  error
+		want ErrorBody
+	}{
+		{
+			name: "message func",
+			err:  errors.New("db: secret"),
+			want: ErrorBody{Code: "INTERNAL", Message: "redacted"},
+		},
+		{
+			name: "public",
+			err:  errcode.WithPublicMessage(errors.New("db: secret"), "try again"),
+			want: ErrorBody{Code: "INTERNAL", Message: "try again"},
+		},
+		{
+			name: "reason",
+			err:  errcode.WithRequestID(errcode.WithReason(errors.New("db: secret"), "example.com", "DB_DOWN"), "req-1"),
+			want: ErrorBody{Code: "INTERNAL", Message: "redacted", Reason: "DB_DOWN", Domain: "example.com", RequestID: "req-1"},
+		},
+	}
+	for _, tt := range tests {
+		if got := NewErrorBody(codes.Internal, tt.err, redact); *got != tt.want {
+			t.Errorf("%s: got %+v; want %+v", tt.name, *got, tt.want)
+		}
+	}
+}
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		code     codes.Code
+		err      error
+		reported int
+		want     int
+	}{
+		{codes.NotFound, errors.New("missing"), 0, http.StatusNotFound},
+		{codes.Unknown, errors.New("method"), http.StatusMethodNotAllowed, http.StatusMethodNotAllowed},
+		{codes.NotFound, errors.New("method"), http.StatusMethodNotAllowed, http.StatusNotFound},
+		{codes.Unavailable, errcode.NewDual(codes.Unavailable, http.StatusTooManyRequests, errors.New("busy")), 0, http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		if got := Status(tt.code, tt.err, tt.reported); got != tt.want {
+			t.Errorf("Status(%v, %v, %d): got %d; want %d", tt.code, tt.err, tt.reported, got, tt.want)
+		}
+	}
+}
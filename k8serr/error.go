@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package k8serr provides the ability to extract the status code from Kubernetes API errors
+// from the k8s.io/apimachinery/pkg/api/errors package.
+package k8serr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"google.golang.org/grpc/codes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Kubernetes ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a Kubernetes API status error. Unknown reasons
+// fall back to the HTTP status code.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	s, ok := errcode.As[apierrors.APIStatus](err)
+	if !ok {
+		return codes.Unknown
+	}
+	// The predicates use errors.As, which isn't depth-limited,
+	// so they're given the status rather than the error's tree.
+	se := &apierrors.StatusError{ErrStatus: s.Status()}
+	switch {
+	case apierrors.IsNotFound(se):
+		return codes.NotFound
+	case apierrors.IsAlreadyExists(se):
+		return codes.AlreadyExists
+	case apierrors.IsConflict(se):
+		return codes.Aborted
+	case apierrors.IsInvalid(se),
+		apierrors.IsBadRequest(se),
+		apierrors.IsNotAcceptable(se),
+		apierrors.IsUnsupportedMediaType(se),
+		apierrors.IsRequestEntityTooLargeError(se):
+		return codes.InvalidArgument
+	case apierrors.IsForbidden(se):
+		return codes.PermissionDenied
+	case apierrors.IsUnauthorized(se):
+		return codes.Unauthenticated
+	case apierrors.IsTooManyRequests(se):
+		return codes.ResourceExhausted
+	case apierrors.IsTimeout(se), apierrors.IsServerTimeout(se):
+		return codes.DeadlineExceeded
+	case apierrors.IsServiceUnavailable(se):
+		return codes.Unavailable
+	case apierrors.IsGone(se), apierrors.IsResourceExpired(se):
+		// The requested resource version is too old and the list must be restarted.
+		return codes.OutOfRange
+	case apierrors.IsMethodNotSupported(se):
+		return codes.Unimplemented
+	case apierrors.IsInternalError(se), apierrors.IsUnexpectedServerError(se):
+		return codes.Internal
+	}
+	return httperr.ToGRPC(int(s.Status().Code))
+}
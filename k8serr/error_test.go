@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package k8serr
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestErrorCoder(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: apierrors.NewNotFound(pods, "web-0"), Want: codes.NotFound},
+		{Name: "already exists", Err: apierrors.NewAlreadyExists(pods, "web-0"), Want: codes.AlreadyExists},
+		{Name: "conflict", Err: apierrors.NewConflict(pods, "web-0", errors.New("object was modified")), Want: codes.Aborted},
+		{Name: "forbidden", Err: apierrors.NewForbidden(pods, "web-0", errors.New("denied")), Want: codes.PermissionDenied},
+		{Name: "unauthorized", Err: apierrors.NewUnauthorized("token expired"), Want: codes.Unauthenticated},
+		{Name: "too many requests", Err: apierrors.NewTooManyRequests("slow down", 1), Want: codes.ResourceExhausted},
+		{Name: "timeout", Err: apierrors.NewTimeoutError("took too long", 1), Want: codes.DeadlineExceeded},
+		{Name: "service unavailable", Err: apierrors.NewServiceUnavailable("down"), Want: codes.Unavailable},
+		{
+			Name: "invalid",
+			Err:  apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "web-0", field.ErrorList{field.Required(field.NewPath("spec"), "")}),
+			Want: codes.InvalidArgument,
+		},
+		{Name: "gone", Err: apierrors.NewResourceExpired("too old resource version"), Want: codes.OutOfRange},
+		{
+			Name: "http status",
+			Err:  &apierrors.StatusError{ErrStatus: metav1.Status{Status: metav1.StatusFailure, Code: http.StatusNotImplemented}},
+			Want: codes.Unimplemented,
+		},
+	})
+}
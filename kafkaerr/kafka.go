@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package kafkaerr provides the gRPC codes associated with Kafka protocol error codes.
+//
+// It doesn't depend on any Kafka client, so it may be shared by the coders of
+// clients that expose the protocol's error codes, such as franz-go's kerr.Error
+// and sarama's KError.
+package kafkaerr
+
+import "google.golang.org/grpc/codes"
+
+// SEE: https://kafka.apache.org/protocol#protocol_error_codes
+
+var kafkaCodes = map[int16]codes.Code{
+	1:   codes.OutOfRange,         // OFFSET_OUT_OF_RANGE
+	2:   codes.DataLoss,           // CORRUPT_MESSAGE
+	3:   codes.NotFound,           // UNKNOWN_TOPIC_OR_PARTITION
+	4:   codes.InvalidArgument,    // INVALID_FETCH_SIZE
+	5:   codes.Unavailable,        // LEADER_NOT_AVAILABLE
+	6:   codes.Unavailable,        // NOT_LEADER_OR_FOLLOWER
+	7:   codes.DeadlineExceeded,   // REQUEST_TIMED_OUT
+	8:   codes.Unavailable,        // BROKER_NOT_AVAILABLE
+	9:   codes.Unavailable,        // REPLICA_NOT_AVAILABLE
+	10:  codes.InvalidArgument,    // MESSAGE_TOO_LARGE
+	11:  codes.FailedPrecondition, // STALE_CONTROLLER_EPOCH
+	12:  codes.InvalidArgument,    // OFFSET_METADATA_TOO_LARGE
+	13:  codes.Unavailable,        // NETWORK_EXCEPTION
+	14:  codes.Unavailable,        // COORDINATOR_LOAD_IN_PROGRESS
+	15:  codes.Unavailable,        // COORDINATOR_NOT_AVAILABLE
+	16:  codes.Unavailable,        // NOT_COORDINATOR
+	17:  codes.InvalidArgument,    // INVALID_TOPIC_EXCEPTION
+	18:  codes.InvalidArgument,    // RECORD_LIST_TOO_LARGE
+	19:  codes.Unavailable,        // NOT_ENOUGH_REPLICAS
+	20:  codes.Unavailable,        // NOT_ENOUGH_REPLICAS_AFTER_APPEND
+	21:  codes.InvalidArgument,    // INVALID_REQUIRED_ACKS
+	22:  codes.Aborted,            // ILLEGAL_GENERATION
+	23:  codes.FailedPrecondition, // INCONSISTENT_GROUP_PROTOCOL
+	24:  codes.InvalidArgument,    // INVALID_GROUP_ID
+	25:  codes.FailedPrecondition, // UNKNOWN_MEMBER_ID
+	26:  codes.InvalidArgument,    // INVALID_SESSION_TIMEOUT
+	27:  codes.Aborted,            // REBALANCE_IN_PROGRESS
+	28:  codes.InvalidArgument,    // INVALID_COMMIT_OFFSET_SIZE
+	29:  codes.PermissionDenied,   // TOPIC_AUTHORIZATION_FAILED
+	30:  codes.PermissionDenied,   // GROUP_AUTHORIZATION_FAILED
+	31:  codes.PermissionDenied,   // CLUSTER_AUTHORIZATION_FAILED
+	32:  codes.InvalidArgument,    // INVALID_TIMESTAMP
+	33:  codes.Unimplemented,      // UNSUPPORTED_SASL_MECHANISM
+	34:  codes.FailedPrecondition, // ILLEGAL_SASL_STATE
+	35:  codes.Unimplemented,      // UNSUPPORTED_VERSION
+	36:  codes.AlreadyExists,      // TOPIC_ALREADY_EXISTS
+	37:  codes.InvalidArgument,    // INVALID_PARTITIONS
+	38:  codes.InvalidArgument,    // INVALID_REPLICATION_FACTOR
+	39:  codes.InvalidArgument,    // INVALID_REPLICA_ASSIGNMENT
+	40:  codes.InvalidArgument,    // INVALID_CONFIG
+	41:  codes.Unavailable,        // NOT_CONTROLLER
+	42:  codes.InvalidArgument,    // INVALID_REQUEST
+	43:  codes.Unimplemented,      // UNSUPPORTED_FOR_MESSAGE_FORMAT
+	44:  codes.FailedPrecondition, // POLICY_VIOLATION
+	45:  codes.Aborted,            // OUT_OF_ORDER_SEQUENCE_NUMBER
+	46:  codes.AlreadyExists,      // DUPLICATE_SEQUENCE_NUMBER
+	47:  codes.Aborted,            // INVALID_PRODUCER_EPOCH
+	48:  codes.FailedPrecondition, // INVALID_TXN_STATE
+	49:  codes.FailedPrecondition, // INVALID_PRODUCER_ID_MAPPING
+	50:  codes.InvalidArgument,    // INVALID_TRANSACTION_TIMEOUT
+	51:  codes.Aborted,            // CONCURRENT_TRANSACTIONS
+	52:  codes.Aborted,            // TRANSACTION_COORDINATOR_FENCED
+	53:  codes.PermissionDenied,   // TRANSACTIONAL_ID_AUTHORIZATION_FAILED
+	54:  codes.FailedPrecondition, // SECURITY_DISABLED
+	55:  codes.Aborted,            // OPERATION_NOT_ATTEMPTED
+	56:  codes.Unavailable,        // KAFKA_STORAGE_ERROR
+	57:  codes.NotFound,           // LOG_DIR_NOT_FOUND
+	58:  codes.Unauthenticated,    // SASL_AUTHENTICATION_FAILED
+	59:  codes.FailedPrecondition, // UNKNOWN_PRODUCER_ID
+	60:  codes.FailedPrecondition, // REASSIGNMENT_IN_PROGRESS
+	61:  codes.FailedPrecondition, // DELEGATION_TOKEN_AUTH_DISABLED
+	62:  codes.NotFound,           // DELEGATION_TOKEN_NOT_FOUND
+	63:  codes.PermissionDenied,   // DELEGATION_TOKEN_OWNER_MISMATCH
+	64:  codes.PermissionDenied,   // DELEGATION_TOKEN_REQUEST_NOT_ALLOWED
+	65:  codes.PermissionDenied,   // DELEGATION_TOKEN_AUTHORIZATION_FAILED
+	66:  codes.Unauthenticated,    // DELEGATION_TOKEN_EXPIRED
+	67:  codes.InvalidArgument,    // INVALID_PRINCIPAL_TYPE
+	68:  codes.FailedPrecondition, // NON_EMPTY_GROUP
+	69:  codes.NotFound,           // GROUP_ID_NOT_FOUND
+	70:  codes.NotFound,           // FETCH_SESSION_ID_NOT_FOUND
+	71:  codes.Aborted,            // INVALID_FETCH_SESSION_EPOCH
+	72:  codes.Unavailable,        // LISTENER_NOT_FOUND
+	73:  codes.FailedPrecondition, // TOPIC_DELETION_DISABLED
+	74:  codes.Unavailable,        // FENCED_LEADER_EPOCH
+	75:  codes.Unavailable,        // UNKNOWN_LEADER_EPOCH
+	76:  codes.Unimplemented,      // UNSUPPORTED_COMPRESSION_TYPE
+	77:  codes.Aborted,            // STALE_BROKER_EPOCH
+	78:  codes.Unavailable,        // OFFSET_NOT_AVAILABLE
+	79:  codes.FailedPrecondition, // MEMBER_ID_REQUIRED
+	80:  codes.Unavailable,        // PREFERRED_LEADER_NOT_AVAILABLE
+	81:  codes.ResourceExhausted,  // GROUP_MAX_SIZE_REACHED
+	82:  codes.Aborted,            // FENCED_INSTANCE_ID
+	83:  codes.Unavailable,        // ELIGIBLE_LEADERS_NOT_AVAILABLE
+	84:  codes.FailedPrecondition, // ELECTION_NOT_NEEDED
+	85:  codes.FailedPrecondition, // NO_REASSIGNMENT_IN_PROGRESS
+	86:  codes.FailedPrecondition, // GROUP_SUBSCRIBED_TO_TOPIC
+	87:  codes.InvalidArgument,    // INVALID_RECORD
+	88:  codes.Unavailable,        // UNSTABLE_OFFSET_COMMIT
+	89:  codes.ResourceExhausted,  // THROTTLING_QUOTA_EXCEEDED
+	90:  codes.Aborted,            // PRODUCER_FENCED
+	91:  codes.NotFound,           // RESOURCE_NOT_FOUND
+	92:  codes.AlreadyExists,      // DUPLICATE_RESOURCE
+	93:  codes.InvalidArgument,    // UNACCEPTABLE_CREDENTIAL
+	94:  codes.FailedPrecondition, // INCONSISTENT_VOTER_SET
+	95:  codes.InvalidArgument,    // INVALID_UPDATE_VERSION
+	96:  codes.Internal,           // FEATURE_UPDATE_FAILED
+	97:  codes.Internal,           // PRINCIPAL_DESERIALIZATION_FAILURE
+	98:  codes.NotFound,           // SNAPSHOT_NOT_FOUND
+	99:  codes.OutOfRange,         // POSITION_OUT_OF_RANGE
+	100: codes.NotFound,           // UNKNOWN_TOPIC_ID
+	101: codes.AlreadyExists,      // DUPLICATE_BROKER_REGISTRATION
+	102: codes.NotFound,           // BROKER_ID_NOT_REGISTERED
+	103: codes.FailedPrecondition, // INCONSISTENT_TOPIC_ID
+	104: codes.FailedPrecondition, // INCONSISTENT_CLUSTER_ID
+	105: codes.NotFound,           // TRANSACTIONAL_ID_NOT_FOUND
+	106: codes.Unavailable,        // FETCH_SESSION_TOPIC_ID_ERROR
+	107: codes.FailedPrecondition, // INELIGIBLE_REPLICA
+	108: codes.Unavailable,        // NEW_LEADER_ELECTED
+}
+
+// Code returns the gRPC code associated with the given Kafka protocol error code.
+// It returns OK for zero (NONE) and Unknown for unrecognized codes.
+func Code(code int16) codes.Code {
+	if code == 0 {
+		return codes.OK
+	}
+	if c, ok := kafkaCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
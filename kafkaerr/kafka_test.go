@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package kafkaerr
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		code int16
+		want codes.Code
+	}{
+		{0, codes.OK},
+		{1, codes.OutOfRange},
+		{3, codes.NotFound},
+		{7, codes.DeadlineExceeded},
+		{10, codes.InvalidArgument},
+		{27, codes.Aborted},
+		{29, codes.PermissionDenied},
+		{36, codes.AlreadyExists},
+		{-1, codes.Unknown},
+		{32767, codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := Code(tt.code); got != tt.want {
+			t.Errorf("Code(%d): got %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"log/slog"
+	"maps"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+)
+
+// LogValue returns a group of the error's code, whether it's retryable,
+// its message, and its metadata, if it has any, as by slogerr.Attrs.
+func (ce *codedError) LogValue() slog.Value { return logValue(ce.code, ce) }
+
+// LogValue returns a group of the error's code, whether it's retryable,
+// its message, and its metadata, if it has any, as by slogerr.Attrs.
+func (de *dualError) LogValue() slog.Value { return logValue(de.code, de) }
+
+func logValue(code codes.Code, err error) slog.Value {
+	_, delayed := RetryDelay(err)
+	attrs := []slog.Attr{
+		slog.String("code", CodeString(code)),
+		slog.Bool("retryable", RetryableCode(code) || delayed),
+		slog.String("message", err.Error()),
+	}
+	if md := Metadata(err); len(md) > 0 {
+		group := make([]slog.Attr, 0, len(md))
+		for _, k := range slices.Sorted(maps.Keys(md)) {
+			group = append(group, slog.String(k, md[k]))
+		}
+		attrs = append(attrs, slog.Attr{Key: "metadata", Value: slog.GroupValue(group...)})
+	}
+	return slog.GroupValue(attrs...)
+}
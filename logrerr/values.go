@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package logrerr provides the ability to log the codes of errors
+// with the github.com/go-logr/logr package.
+package logrerr
+
+import "bursavich.dev/errcode"
+
+// Keys of the values returned by KeysAndValues.
+const (
+	CodeKey      = "code"
+	CodeNameKey  = "codeName"
+	RetryableKey = "retryable"
+	MetadataKey  = "metadata"
+)
+
+// KeysAndValues returns key-value pairs that describe the error: its code
+// resolved by the coders, the code's canonical name, such as "NOT_FOUND",
+// whether it's retryable, and its metadata, if it has any. An error is
+// retryable if its code is retryable, as reported by errcode.RetryableCode,
+// or if it reports a retry delay, as reported by errcode.RetryDelay.
+// The metadata's value is a map, as reported by errcode.Metadata.
+//
+// They may be passed to the methods of a logr.Logger:
+//
+//	logger.Error(err, "request failed", logrerr.KeysAndValues(err, coder)...)
+//
+// If the error is nil, it returns nil.
+func KeysAndValues(err error, coders ...errcode.ErrorCoder) []any {
+	if err == nil {
+		return nil
+	}
+	code := errcode.Compact(coders...).ErrorCode(err)
+	_, delayed := errcode.RetryDelay(err)
+	kvs := []any{
+		CodeKey, uint32(code),
+		CodeNameKey, errcode.CodeString(code),
+		RetryableKey, errcode.RetryableCode(code) || delayed,
+	}
+	if md := errcode.Metadata(err); len(md) > 0 {
+		kvs = append(kvs, MetadataKey, md)
+	}
+	return kvs
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package logrerr
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+func TestKeysAndValues(t *testing.T) {
+	if got := KeysAndValues(nil); got != nil {
+		t.Errorf("nil: got %v; want nil", got)
+	}
+	err := fmt.Errorf("query: %w", context.DeadlineExceeded)
+	want := []any{
+		CodeKey, uint32(codes.DeadlineExceeded),
+		CodeNameKey, "DEADLINE_EXCEEDED",
+		RetryableKey, false,
+	}
+	if got := KeysAndValues(err, errcode.ContextErrorCoder()); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestKeysAndValuesMetadata(t *testing.T) {
+	err := errcode.WithRequestID(fmt.Errorf("query: %w", context.Canceled), "req-1")
+	want := []any{
+		CodeKey, uint32(codes.Canceled),
+		CodeNameKey, "CANCELLED",
+		RetryableKey, false,
+		MetadataKey, map[string]string{errcode.RequestIDKey: "req-1"},
+	}
+	if got := KeysAndValues(err, errcode.ContextErrorCoder()); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
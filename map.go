@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+)
+
+type sentinel struct {
+	err        error
+	comparable bool
+	code       codes.Code
+}
+
+type sentinelErrorCoder struct {
+	sentinels []sentinel
+}
+
+// MapErrors returns an ErrorCoder that maps sentinel errors to codes.
+// An error matches a sentinel if Is would report that it does.
+//
+// If an error matches more than one sentinel, the one that is found first
+// in a pre-order traversal of the error's tree is used.
+func MapErrors(m map[error]codes.Code) ErrorCoder {
+	s := make([]sentinel, 0, len(m))
+	for err, code := range m {
+		if err == nil {
+			continue
+		}
+		s = append(s, sentinel{
+			err:        err,
+			comparable: reflect.TypeOf(err).Comparable(),
+			code:       code,
+		})
+	}
+	// Sort for deterministic results when a single error matches multiple sentinels.
+	slices.SortFunc(s, func(a, b sentinel) int { return cmp.Compare(a.err.Error(), b.err.Error()) })
+	return &sentinelErrorCoder{s}
+}
+
+func (c *sentinelErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	code := codes.Unknown
+	Walk(err, func(err error) bool {
+		for _, s := range c.sentinels {
+			if is(err, s) {
+				code = s.code
+				return false
+			}
+		}
+		return true
+	})
+	return code
+}
+
+func is(err error, s sentinel) bool {
+	if s.comparable && err == s.err {
+		return true
+	}
+	if x, ok := err.(interface{ Is(error) bool }); ok && x.Is(s.err) {
+		return true
+	}
+	return false
+}
+
+// A TypeRule maps errors of a particular type to a code.
+// It is created by Type or TypeFunc.
+type TypeRule struct {
+	fn func(error) (codes.Code, bool)
+}
+
+// Type returns a TypeRule that maps errors of type T to the given code.
+// An error matches if As would find a T in its tree.
+func Type[T error](code codes.Code) TypeRule {
+	return TypeFunc(func(T) codes.Code { return code })
+}
+
+// TypeFunc returns a TypeRule that maps errors of type T to the code
+// returned by fn. An error matches if As would find a T in its tree.
+func TypeFunc[T error](fn func(T) codes.Code) TypeRule {
+	return TypeRule{func(err error) (codes.Code, bool) {
+		if t, ok := As[T](err); ok {
+			return fn(t), true
+		}
+		return codes.Unknown, false
+	}}
+}
+
+type typeErrorCoder struct {
+	rules []TypeRule
+}
+
+// MapType returns an ErrorCoder that maps errors of type T to the given code.
+// An error matches if As would find a T in its tree.
+func MapType[T error](code codes.Code) ErrorCoder {
+	return MapTypes(Type[T](code))
+}
+
+// MapTypes returns an ErrorCoder that maps errors to codes by type.
+// The rules are checked in order and the first match is used.
+func MapTypes(rules ...TypeRule) ErrorCoder {
+	return &typeErrorCoder{slices.Clone(rules)}
+}
+
+func (c *typeErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	for _, r := range c.rules {
+		if code, ok := r.fn(err); ok {
+			return code
+		}
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestMapErrors(t *testing.T) {
+	errNotFound := errors.New("not found")
+	errConflict := errors.New("conflict")
+	coder := MapErrors(map[error]codes.Code{
+		errNotFound: codes.NotFound,
+		errConflict: codes.Aborted,
+	})
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errNotFound, codes.NotFound},
+		{fmt.Errorf("wrapped: %w", errConflict), codes.Aborted},
+		{fmt.Errorf("outer: %w", fmt.Errorf("%w: %w", errConflict, errNotFound)), codes.Aborted},
+		{errors.Join(errNotFound, errConflict), codes.NotFound},
+		{errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := coder.ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+type testError struct{ code codes.Code }
+
+func (e *testError) Error() string { return e.code.String() }
+
+func TestMapTypes(t *testing.T) {
+	coder := MapTypes(
+		Type[*fs.PathError](codes.NotFound),
+		TypeFunc(func(e *testError) codes.Code { return e.code }),
+		Type[interface {
+			Timeout() bool
+			error
+		}](codes.DeadlineExceeded),
+	)
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{&fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}, codes.NotFound},
+		{fmt.Errorf("wrapped: %w", &testError{codes.Aborted}), codes.Aborted},
+		{os.ErrDeadlineExceeded, codes.DeadlineExceeded},
+		{errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := coder.ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package memcacheerr provides the ability to extract the status code from Memcached errors
+// from the github.com/bradfitz/gomemcache/memcache package.
+package memcacheerr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/bradfitz/gomemcache/memcache"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Memcached ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	memcache.ErrCacheMiss:    codes.NotFound,
+	memcache.ErrNotStored:    codes.Aborted,
+	memcache.ErrCASConflict:  codes.Aborted,
+	memcache.ErrServerError:  codes.Internal,
+	memcache.ErrNoServers:    codes.Unavailable,
+	memcache.ErrMalformedKey: codes.InvalidArgument,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known gomemcache error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if code := sentinelCoder.ErrorCode(err); code != codes.Unknown {
+		return code
+	}
+	if _, ok := errcode.As[*memcache.ConnectTimeoutError](err); ok {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package memcacheerr
+
+import (
+	"net"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/bradfitz/gomemcache/memcache"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "cache miss", Err: memcache.ErrCacheMiss, Want: codes.NotFound},
+		{Name: "not stored", Err: memcache.ErrNotStored, Want: codes.Aborted},
+		{Name: "cas conflict", Err: memcache.ErrCASConflict, Want: codes.Aborted},
+		{Name: "server error", Err: memcache.ErrServerError, Want: codes.Internal},
+		{Name: "no servers", Err: memcache.ErrNoServers, Want: codes.Unavailable},
+		{Name: "malformed key", Err: memcache.ErrMalformedKey, Want: codes.InvalidArgument},
+		{Name: "connect timeout", Err: &memcache.ConnectTimeoutError{Addr: &net.TCPAddr{Port: 11211}}, Want: codes.Unavailable},
+	})
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// A MessageRule maps errors with matching messages to a code.
+//
+// If both Contains and Regexp are set, a message must satisfy both.
+// If neither is set, every message matches.
+type MessageRule struct {
+	// Contains is a substring that the message must contain.
+	Contains string
+	// Regexp is a regular expression that the message must match.
+	Regexp *regexp.Regexp
+	// Code is the code of matching errors.
+	Code codes.Code
+}
+
+type messageMatcher struct {
+	contains string
+	regexp   *regexp.Regexp
+	code     codes.Code
+}
+
+type messageErrorCoder struct {
+	matchers []messageMatcher
+}
+
+// MatchMessage returns an ErrorCoder that maps errors to codes by their messages.
+// It is intended for third-party errors that are otherwise opaque and should usually
+// be scoped with When.
+//
+// The rules are checked in order and the first match is used.
+// Each error's message is computed once per call, regardless of the number of rules.
+func MatchMessage(rules []MessageRule) ErrorCoder {
+	c := &messageErrorCoder{make([]messageMatcher, 0, len(rules))}
+	for _, r := range rules {
+		m := messageMatcher{contains: r.Contains, regexp: r.Regexp, code: r.Code}
+		if m.regexp != nil && m.contains == "" {
+			// Use the regexp's literal prefix, if any, to reject messages without running the regexp.
+			if prefix, _ := m.regexp.LiteralPrefix(); prefix != "" {
+				m.contains = prefix
+			}
+		}
+		c.matchers = append(c.matchers, m)
+	}
+	return c
+}
+
+func (c *messageErrorCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	msg := err.Error()
+	for _, m := range c.matchers {
+		if m.contains != "" && !strings.Contains(msg, m.contains) {
+			continue
+		}
+		if m.regexp != nil && !m.regexp.MatchString(msg) {
+			continue
+		}
+		return m.code
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestMatchMessage(t *testing.T) {
+	coder := MatchMessage([]MessageRule{
+		{Contains: "no such user", Code: codes.NotFound},
+		{Regexp: regexp.MustCompile(`^quota: \d+ requests exceeded`), Code: codes.ResourceExhausted},
+		{Contains: "timeout", Regexp: regexp.MustCompile(`after \d+s$`), Code: codes.DeadlineExceeded},
+		{Contains: "timeout", Code: codes.Unavailable},
+	})
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errors.New("lookup: no such user"), codes.NotFound},
+		{errors.New("quota: 100 requests exceeded"), codes.ResourceExhausted},
+		{errors.New("rpc: quota: 100 requests exceeded"), codes.Unknown},
+		{errors.New("timeout after 5s"), codes.DeadlineExceeded},
+		{errors.New("timeout"), codes.Unavailable},
+		{errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := coder.ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+// RequestIDKey is the metadata key of an error's request ID.
+const RequestIDKey = "request_id"
+
+// A MetadataError is an error with key-value metadata, such as the ID of the
+// request that caused it, which may be used to correlate error responses with
+// logs.
+type MetadataError interface {
+	Metadata() map[string]string
+	error
+}
+
+// WithMetadata wraps the given error and adds the key-value pair to its
+// metadata. If the error is nil, it returns nil.
+//
+// The logging integrations of this module log the metadata, and the status
+// conversions of this module send it to clients in the ErrorInfo detail of an
+// error with a reason, so it must not contain secrets. The request ID is also
+// sent in RequestInfo details and HTTP response bodies.
+func WithMetadata(err error, key, value string) error {
+	if err == nil {
+		return nil
+	}
+	return &metadataError{err, key, value}
+}
+
+// WithRequestID wraps the given error and adds the ID of the request that
+// caused it to its metadata. If the error is nil, it returns nil.
+func WithRequestID(err error, id string) error {
+	return WithMetadata(err, RequestIDKey, id)
+}
+
+type metadataError struct {
+	err   error
+	key   string
+	value string
+}
+
+func (me *metadataError) Metadata() map[string]string { return map[string]string{me.key: me.value} }
+func (me *metadataError) Error() string               { return me.err.Error() }
+func (me *metadataError) Unwrap() error               { return me.err }
+
+// Metadata returns the merged metadata of every MetadataError in err's tree,
+// or nil if there isn't any. If a key is repeated, the value that appears first
+// in a pre-order traversal is used, so outer errors take precedence.
+func Metadata(err error) map[string]string {
+	var md map[string]string
+	Walk(err, func(err error) bool {
+		e, ok := err.(MetadataError)
+		if !ok {
+			return true
+		}
+		for k, v := range e.Metadata() {
+			if _, ok := md[k]; ok {
+				continue
+			}
+			if md == nil {
+				md = make(map[string]string)
+			}
+			md[k] = v
+		}
+		return true
+	})
+	return md
+}
+
+// RequestID returns the request ID in err's metadata and reports whether
+// there is one.
+func RequestID(err error) (string, bool) {
+	id, ok := Metadata(err)[RequestIDKey]
+	return id, ok
+}
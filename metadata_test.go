@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+type tenantError struct{ error }
+
+func (tenantError) Metadata() map[string]string {
+	return map[string]string{"tenant": "acme", "region": "us-east1"}
+}
+
+func TestMetadata(t *testing.T) {
+	if err := WithMetadata(nil, "k", "v"); err != nil {
+		t.Errorf("WithMetadata(nil): got %v; want nil", err)
+	}
+	if err := WithRequestID(nil, "req-1"); err != nil {
+		t.Errorf("WithRequestID(nil): got %v; want nil", err)
+	}
+	if md := Metadata(errors.New("plain")); md != nil {
+		t.Errorf("Metadata: got %v; want nil", md)
+	}
+	if id, ok := RequestID(errors.New("plain")); ok {
+		t.Errorf("RequestID: got %q; want none", id)
+	}
+
+	inner := New(codes.NotFound, tenantError{errors.New("no rows")})
+	err := WithRequestID(
+		fmt.Errorf("get user: %w", errors.Join(
+			WithMetadata(inner, "region", "eu-west1"),
+			WithRequestID(errors.New("other"), "req-2"),
+		)),
+		"req-1",
+	)
+	want := map[string]string{
+		RequestIDKey: "req-1",
+		"region":     "eu-west1",
+		"tenant":     "acme",
+	}
+	if md := Metadata(err); !maps.Equal(md, want) {
+		t.Errorf("Metadata: got %v; want %v", md, want)
+	}
+	if id, ok := RequestID(err); !ok || id != "req-1" {
+		t.Errorf("RequestID: got (%q, %v); want (%q, true)", id, ok, "req-1")
+	}
+	if got := CodedErrorCoder().ErrorCode(err); got != codes.NotFound {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.NotFound)
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package mongoerr provides the ability to extract the status code from MongoDB errors
+// from the go.mongodb.org/mongo-driver/mongo package.
+package mongoerr
+
+import (
+	"bursavich.dev/errcode"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the MongoDB ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://www.mongodb.com/docs/manual/reference/error-codes/
+
+var serverCodes = map[int]codes.Code{
+	11601: codes.Canceled, // Interrupted
+
+	2:   codes.InvalidArgument, // BadValue
+	9:   codes.InvalidArgument, // FailedToParse
+	14:  codes.InvalidArgument, // TypeMismatch
+	121: codes.InvalidArgument, // DocumentValidationFailure
+
+	50:  codes.DeadlineExceeded, // MaxTimeMSExpired
+	64:  codes.DeadlineExceeded, // WriteConcernFailed
+	89:  codes.DeadlineExceeded, // NetworkTimeout
+	262: codes.DeadlineExceeded, // ExceededTimeLimit
+
+	26: codes.NotFound, // NamespaceNotFound
+	43: codes.NotFound, // CursorNotFound
+
+	48:    codes.AlreadyExists, // NamespaceExists
+	11000: codes.AlreadyExists, // DuplicateKey
+	11001: codes.AlreadyExists, // DuplicateKey on update
+	12582: codes.AlreadyExists, // DuplicateKey in a capped collection
+
+	13: codes.PermissionDenied, // Unauthorized
+
+	146:   codes.ResourceExhausted, // ExceededMemoryLimit
+	14031: codes.ResourceExhausted, // OutOfDiskSpace
+
+	20: codes.FailedPrecondition, // IllegalOperation
+
+	24:  codes.Aborted, // LockTimeout
+	112: codes.Aborted, // WriteConflict
+	251: codes.Aborted, // NoSuchTransaction
+
+	59:  codes.Unimplemented, // CommandNotFound
+	115: codes.Unimplemented, // CommandNotSupported
+
+	6:     codes.Unavailable, // HostUnreachable
+	7:     codes.Unavailable, // HostNotFound
+	91:    codes.Unavailable, // ShutdownInProgress
+	189:   codes.Unavailable, // PrimarySteppedDown
+	10107: codes.Unavailable, // NotWritablePrimary
+	11600: codes.Unavailable, // InterruptedAtShutdown
+	11602: codes.Unavailable, // InterruptedDueToReplStateChange
+	13435: codes.Unavailable, // NotPrimaryNoSecondaryOk
+	13436: codes.Unavailable, // NotPrimaryOrSecondary
+
+	18: codes.Unauthenticated, // AuthenticationFailed
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a MongoDB server error or a known driver error.
+//
+// The first recognized code among the write errors of a WriteException
+// or BulkWriteException is used, followed by that of the write concern error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if errcode.Is(err, mongo.ErrNoDocuments) {
+		return codes.NotFound
+	}
+	if e, ok := errcode.As[mongo.CommandError](err); ok {
+		if code, ok := serverCodes[int(e.Code)]; ok {
+			return code
+		}
+	}
+	if e, ok := errcode.As[mongo.WriteException](err); ok {
+		for _, we := range e.WriteErrors {
+			if code, ok := serverCodes[we.Code]; ok {
+				return code
+			}
+		}
+		if e.WriteConcernError != nil {
+			if code, ok := serverCodes[e.WriteConcernError.Code]; ok {
+				return code
+			}
+		}
+	}
+	if e, ok := errcode.As[mongo.BulkWriteException](err); ok {
+		for _, we := range e.WriteErrors {
+			if code, ok := serverCodes[we.Code]; ok {
+				return code
+			}
+		}
+		if e.WriteConcernError != nil {
+			if code, ok := serverCodes[e.WriteConcernError.Code]; ok {
+				return code
+			}
+		}
+	}
+	if !errcode.WithinLimits(err) {
+		// The mongo predicates traverse the tree without limits.
+		return codes.Unknown
+	}
+	if mongo.IsTimeout(err) {
+		return codes.DeadlineExceeded
+	}
+	if mongo.IsNetworkError(err) {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// ServerCode returns the gRPC code associated with the given MongoDB server error code.
+func ServerCode(code int) codes.Code {
+	if c, ok := serverCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mongoerr
+
+import (
+	"context"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "no documents", Err: mongo.ErrNoDocuments, Want: codes.NotFound},
+		{Name: "unauthorized", Err: mongo.CommandError{Code: 13, Name: "Unauthorized"}, Want: codes.PermissionDenied},
+		{Name: "stepdown", Err: mongo.CommandError{Code: 189, Name: "PrimarySteppedDown"}, Want: codes.Unavailable},
+		{Name: "not primary", Err: mongo.CommandError{Code: 10107, Name: "NotWritablePrimary"}, Want: codes.Unavailable},
+		{
+			Name: "duplicate key",
+			Err:  mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000, Message: "E11000 duplicate key"}}},
+			Want: codes.AlreadyExists,
+		},
+		{
+			Name: "write concern",
+			Err:  mongo.WriteException{WriteConcernError: &mongo.WriteConcernError{Code: 64, Message: "waiting for replication timed out"}},
+			Want: codes.DeadlineExceeded,
+		},
+		{
+			Name: "bulk duplicate key",
+			Err: mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{
+				{WriteError: mongo.WriteError{Code: 1, Message: "unrecognized"}},
+				{WriteError: mongo.WriteError{Code: 11000, Message: "E11000 duplicate key"}},
+			}},
+			Want: codes.AlreadyExists,
+		},
+		{Name: "timeout", Err: context.DeadlineExceeded, Want: codes.DeadlineExceeded},
+		{Name: "unmapped", Err: mongo.CommandError{Code: 1, Name: "InternalError"}, Want: codes.Unknown},
+	})
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package mqtterr provides the ability to extract the status code from MQTT errors
+// from the github.com/eclipse/paho.mqtt.golang package.
+package mqtterr
+
+import (
+	"bursavich.dev/errcode"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the MQTT ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	mqtt.ErrInvalidQos:              codes.InvalidArgument,
+	mqtt.ErrInvalidTopicEmptyString: codes.InvalidArgument,
+	mqtt.ErrInvalidTopicMultilevel:  codes.InvalidArgument,
+
+	mqtt.TimedOut: codes.DeadlineExceeded,
+
+	mqtt.ErrNotConnected:                  codes.Unavailable,
+	packets.ErrorRefusedServerUnavailable: codes.Unavailable,
+	packets.ErrorNetworkError:             codes.Unavailable,
+
+	packets.ErrorRefusedBadUsernameOrPassword: codes.Unauthenticated,
+	packets.ErrorRefusedNotAuthorised:         codes.PermissionDenied,
+
+	packets.ErrorRefusedBadProtocolVersion: codes.FailedPrecondition,
+	packets.ErrorRefusedIDRejected:         codes.InvalidArgument,
+	packets.ErrorProtocolViolation:         codes.Internal,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known MQTT error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return sentinelCoder.ErrorCode(err)
+}
+
+// SEE: https://docs.oasis-open.org/mqtt/mqtt/v5.0/os/mqtt-v5.0-os.html#_Toc3901031
+
+var reasonCodes = map[byte]codes.Code{
+	0x81: codes.InvalidArgument, // Malformed Packet
+	0x82: codes.InvalidArgument, // Protocol Error
+	0x85: codes.InvalidArgument, // Client Identifier not valid
+	0x8F: codes.InvalidArgument, // Topic Filter invalid
+	0x90: codes.InvalidArgument, // Topic Name invalid
+	0x95: codes.InvalidArgument, // Packet too large
+	0x99: codes.InvalidArgument, // Payload format invalid
+
+	0x8D: codes.DeadlineExceeded, // Keep Alive timeout
+
+	0x92: codes.NotFound, // Packet Identifier not found
+
+	0x91: codes.AlreadyExists, // Packet Identifier in use
+
+	0x87: codes.PermissionDenied, // Not authorized
+	0x8A: codes.PermissionDenied, // Banned
+
+	0x89: codes.ResourceExhausted, // Server busy
+	0x93: codes.ResourceExhausted, // Receive Maximum exceeded
+	0x96: codes.ResourceExhausted, // Message rate too high
+	0x97: codes.ResourceExhausted, // Quota exceeded
+	0x9F: codes.ResourceExhausted, // Connection rate exceeded
+
+	0x83: codes.FailedPrecondition, // Implementation specific error
+	0x84: codes.FailedPrecondition, // Unsupported Protocol Version
+	0x8E: codes.FailedPrecondition, // Session taken over
+	0x94: codes.FailedPrecondition, // Topic Alias invalid
+	0x9A: codes.FailedPrecondition, // Retain not supported
+	0x9B: codes.FailedPrecondition, // QoS not supported
+
+	0x9E: codes.Unimplemented, // Shared Subscriptions not supported
+	0xA1: codes.Unimplemented, // Subscription Identifiers not supported
+	0xA2: codes.Unimplemented, // Wildcard Subscriptions not supported
+
+	0x80: codes.Internal, // Unspecified error
+
+	0x88: codes.Unavailable, // Server unavailable
+	0x8B: codes.Unavailable, // Server shutting down
+	0x9C: codes.Unavailable, // Use another server
+	0x9D: codes.Unavailable, // Server moved
+
+	0x86: codes.Unauthenticated, // Bad User Name or Password
+	0x8C: codes.Unauthenticated, // Bad authentication method
+}
+
+// ReasonCode returns the gRPC code associated with the given MQTT v5 reason code,
+// such as those reported by the github.com/eclipse/paho.golang package.
+// Reason codes below 0x80 indicate success. It returns codes.Unknown
+// if the reason code isn't a recognized failure.
+func ReasonCode(code byte) codes.Code {
+	if code < 0x80 {
+		return codes.OK
+	}
+	if c, ok := reasonCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mqtterr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "invalid qos", Err: mqtt.ErrInvalidQos, Want: codes.InvalidArgument},
+		{Name: "timed out", Err: mqtt.TimedOut, Want: codes.DeadlineExceeded},
+		{Name: "not connected", Err: mqtt.ErrNotConnected, Want: codes.Unavailable},
+		{Name: "bad credentials", Err: packets.ErrorRefusedBadUsernameOrPassword, Want: codes.Unauthenticated},
+		{Name: "not authorized", Err: packets.ErrorRefusedNotAuthorised, Want: codes.PermissionDenied},
+		{Name: "protocol violation", Err: packets.ErrorProtocolViolation, Want: codes.Internal},
+	})
+}
+
+func TestReasonCode(t *testing.T) {
+	for _, tt := range []struct {
+		code byte
+		want codes.Code
+	}{
+		{0x00, codes.OK},
+		{0x10, codes.OK},
+		{0x80, codes.Internal},
+		{0x87, codes.PermissionDenied},
+		{0x89, codes.ResourceExhausted},
+		{0x8B, codes.Unavailable},
+		{0xA2, codes.Unimplemented},
+		{0xFF, codes.Unknown},
+	} {
+		if got := ReasonCode(tt.code); got != tt.want {
+			t.Errorf("ReasonCode(%#x) = %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package mssqlerr provides the ability to extract the status code from SQL Server errors
+// from the github.com/microsoft/go-mssqldb package.
+package mssqlerr
+
+import (
+	"bursavich.dev/errcode"
+	mssql "github.com/microsoft/go-mssqldb"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the SQL Server ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://learn.microsoft.com/en-us/sql/relational-databases/errors-events/database-engine-events-and-errors
+
+var mssqlCodes = map[int32]codes.Code{
+	3980: codes.Canceled, // The request failed to run because the batch is aborted
+
+	102:  codes.InvalidArgument, // Incorrect syntax near '%.*ls'.
+	156:  codes.InvalidArgument, // Incorrect syntax near the keyword '%.*ls'.
+	207:  codes.InvalidArgument, // Invalid column name '%.*ls'.
+	245:  codes.InvalidArgument, // Conversion failed when converting the %ls value '%.*ls' to data type %ls.
+	515:  codes.InvalidArgument, // Cannot insert the value NULL into column '%.*ls', table '%.*ls'; column does not allow nulls.
+	2628: codes.InvalidArgument, // String or binary data would be truncated in table '%.*ls', column '%.*ls'.
+	8114: codes.InvalidArgument, // Error converting data type %ls to %ls.
+	8152: codes.InvalidArgument, // String or binary data would be truncated.
+
+	-2:   codes.DeadlineExceeded, // Timeout expired
+	1222: codes.DeadlineExceeded, // Lock request time out period exceeded.
+
+	208:  codes.NotFound, // Invalid object name '%.*ls'.
+	911:  codes.NotFound, // Database '%.*ls' does not exist.
+	2812: codes.NotFound, // Could not find stored procedure '%.*ls'.
+	3701: codes.NotFound, // Cannot %S_MSG the %S_MSG '%.*ls', because it does not exist or you do not have permission.
+
+	1801: codes.AlreadyExists, // Database '%.*ls' already exists.
+	2601: codes.AlreadyExists, // Cannot insert duplicate key row in object '%.*ls' with unique index '%.*ls'.
+	2627: codes.AlreadyExists, // Violation of %ls constraint '%.*ls'. Cannot insert duplicate key in object '%.*ls'.
+	2714: codes.AlreadyExists, // There is already an object named '%.*ls' in the database.
+
+	229:   codes.PermissionDenied, // The %ls permission was denied on the object '%.*ls', database '%.*ls', schema '%.*ls'.
+	230:   codes.PermissionDenied, // The %ls permission was denied on the column '%.*ls' of the object '%.*ls', database '%.*ls', schema '%.*ls'.
+	262:   codes.PermissionDenied, // %ls permission denied in database '%.*ls'.
+	300:   codes.PermissionDenied, // %ls permission was denied on object '%.*ls', database '%.*ls'.
+	916:   codes.PermissionDenied, // The server principal "%.*ls" is not able to access the database "%.*ls" under the current security context.
+	4060:  codes.PermissionDenied, // Cannot open database "%.*ls" requested by the login. The login failed.
+	18452: codes.PermissionDenied, // Login failed. The login is from an untrusted domain and cannot be used with Windows authentication.
+	18456: codes.PermissionDenied, // Login failed for user '%.*ls'.
+	18486: codes.PermissionDenied, // Login failed for user '%.*ls' because the account is currently locked out.
+	18487: codes.PermissionDenied, // Login failed for user '%.*ls'. Reason: The password of the account has expired.
+	18488: codes.PermissionDenied, // Login failed for user '%.*ls'. Reason: The password of the account must be changed.
+
+	701:   codes.ResourceExhausted, // There is insufficient system memory in resource pool '%ls' to run this query.
+	1105:  codes.ResourceExhausted, // Could not allocate space for object '%.*ls' in database '%.*ls' because the '%.*ls' filegroup is full.
+	8645:  codes.ResourceExhausted, // A timeout occurred while waiting for memory resources to execute the query.
+	9002:  codes.ResourceExhausted, // The transaction log for database '%.*ls' is full.
+	10928: codes.ResourceExhausted, // Resource ID: %d. The %s limit for the database is %d and has been reached.
+	10929: codes.ResourceExhausted, // Resource ID: %d. The %s minimum guarantee is %d, maximum limit is %d, and the current usage for the database is %d.
+
+	547:  codes.FailedPrecondition, // The %ls statement conflicted with the %ls constraint "%.*ls".
+	3902: codes.FailedPrecondition, // The COMMIT TRANSACTION request has no corresponding BEGIN TRANSACTION.
+	3903: codes.FailedPrecondition, // The ROLLBACK TRANSACTION request has no corresponding BEGIN TRANSACTION.
+
+	1205: codes.Aborted, // Transaction (Process ID %d) was deadlocked on %.*ls resources with another process and has been chosen as the deadlock victim.
+	3960: codes.Aborted, // Snapshot isolation transaction aborted due to update conflict.
+
+	8115: codes.OutOfRange, // Arithmetic overflow error converting %ls to data type %ls.
+
+	40197: codes.Unavailable, // The service has encountered an error processing your request. Please try again.
+	40501: codes.Unavailable, // The service is currently busy. Retry the request after 10 seconds.
+	40613: codes.Unavailable, // Database '%.*ls' on server '%.*ls' is not currently available.
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an mssql.Error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[mssql.Error](err); ok {
+		if code, ok := mssqlCodes[e.Number]; ok {
+			return code
+		}
+	}
+	if _, ok := errcode.As[mssql.StreamError](err); ok {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
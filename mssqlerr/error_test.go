@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mssqlerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	mssql "github.com/microsoft/go-mssqldb"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "duplicate key", Err: mssql.Error{Number: 2627}, Want: codes.AlreadyExists},
+		{Name: "duplicate index key", Err: mssql.Error{Number: 2601}, Want: codes.AlreadyExists},
+		{Name: "deadlock", Err: mssql.Error{Number: 1205}, Want: codes.Aborted},
+		{Name: "constraint", Err: mssql.Error{Number: 547}, Want: codes.FailedPrecondition},
+		{Name: "timeout", Err: mssql.Error{Number: -2}, Want: codes.DeadlineExceeded},
+		{Name: "login failed", Err: mssql.Error{Number: 18456}, Want: codes.PermissionDenied},
+		{Name: "unmapped", Err: mssql.Error{Number: 50000}, Want: codes.Unknown},
+		{Name: "stream", Err: mssql.StreamError{}, Want: codes.Internal},
+	})
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Code generated by gentables; DO NOT EDIT.
+
+package mysqlerr
+
+import "google.golang.org/grpc/codes"
+
+// SEE: https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+
+// mysqlCode returns the code mapped to the key and reports whether it's mapped.
+func mysqlCode(key uint16) (codes.Code, bool) {
+	switch key {
+	case 1317: // ER_QUERY_INTERRUPTED; Query execution was interrupted
+		return codes.Canceled, true
+
+	case 1048, // ER_BAD_NULL_ERROR; Column '%s' cannot be null
+		1149, // ER_SYNTAX_ERROR; You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version for the right syntax to use
+		1406, // ER_DATA_TOO_LONG; Data too long for column '%s' at row %ld
+		3819: // ER_CHECK_CONSTRAINT_VIOLATED; Check constraint '%s' is violated.
+		return codes.InvalidArgument, true
+
+	case 1264: // ER_WARN_DATA_OUT_OF_RANGE; Out of range value for column '%s' at row %ld
+		return codes.OutOfRange, true
+
+	case 1216, // ER_NO_REFERENCED_ROW; Cannot add or update a child row: a foreign key constraint fails
+		1217, // ER_ROW_IS_REFERENCED; Cannot delete or update a parent row: a foreign key constraint fails
+		1451, // ER_ROW_IS_REFERENCED_2; Cannot delete or update a parent row: a foreign key constraint fails (%s)
+		1452: // ER_NO_REFERENCED_ROW_2; Cannot add or update a child row: a foreign key constraint fails (%s)
+		return codes.FailedPrecondition, true
+
+	case 1205: // ER_LOCK_WAIT_TIMEOUT; Lock wait timeout exceeded; try restarting transaction
+		return codes.DeadlineExceeded, true
+
+	case 1008, // ER_DB_DROP_EXISTS; Can't drop database '%s'; database doesn't exist
+		1017, // ER_FILE_NOT_FOUND; Can't find file: '%s' (errno: %d - %s)
+		1031, // ER_KEY_NOT_FOUND; Can't find record in '%s'
+		1049, // ER_BAD_DB_ERROR; Unknown database '%s'
+		1051, // ER_BAD_TABLE_ERROR; Unknown table '%s'
+		1106, // ER_UNKNOWN_PROCEDURE; Unknown procedure '%s'
+		1109, // ER_UNKNOWN_TABLE; Unknown table '%s' in %s
+		1133, // ER_PASSWORD_NO_MATCH; Can't find any matching row in the user table
+		1146, // ER_NO_SUCH_TABLE; Table '%s.%s' doesn't exist
+		1176, // ER_KEY_DOES_NOT_EXITS; Key '%s' doesn't exist in table '%s'
+		1305: // ER_SP_DOES_NOT_EXIST; %s %s does not exist
+		return codes.NotFound, true
+
+	case 1007, // ER_DB_CREATE_EXISTS; Can't create database '%s'; database exists
+		1022, // ER_DUP_KEY; Can't write; duplicate key in table '%s'
+		1050, // ER_TABLE_EXISTS_ERROR; Table '%s' already exists
+		1062, // ER_DUP_ENTRY; Duplicate entry '%s' for key %d
+		1086, // ER_FILE_EXISTS_ERROR; File '%s' already exists
+		1169, // ER_DUP_UNIQUE; Can't write, because of unique constraint, to table '%s'
+		1304: // ER_SP_ALREADY_EXISTS; %s %s already exists
+		return codes.AlreadyExists, true
+
+	case 1044, // ER_DBACCESS_DENIED_ERROR; Access denied for user '%s'@'%s' to database '%s'
+		1045,  // ER_ACCESS_DENIED_ERROR; Access denied for user '%s'@'%s' (using password: %s)
+		1130,  // ER_HOST_NOT_PRIVILEGED; Host '%s' is not allowed to connect to this MySQL server
+		1132,  // ER_PASSWORD_NOT_ALLOWED; You must have privileges to update tables in the mysql database to be able to change passwords for others
+		1142,  // ER_TABLEACCESS_DENIED_ERROR; %s command denied to user '%s'@'%s' for table '%s'
+		1143,  // ER_COLUMNACCESS_DENIED_ERROR; %s command denied to user '%s'@'%s' for column '%s' in table '%s'
+		1227,  // ER_SPECIFIC_ACCESS_DENIED_ERROR; SQLSTATE: Access denied; you need (at least one of) the %s privilege(s) for this operation
+		1698,  // ER_ACCESS_DENIED_NO_PASSWORD_ERROR; Access denied for user '%s'@'%s'
+		3118,  // ER_ACCOUNT_HAS_BEEN_LOCKED; Access denied for user '%s'@'%s'. Account is locked.
+		3879,  // ER_DB_ACCESS_DENIED; Access denied for AuthId `%s`@`%s` to database '%s
+		3955,  // ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK; Access denied for user '%s'@'%s'. Account is blocked for %s day(s) (%s day(s) remaining) due to %u consecutive failed logins.
+		10926, // ER_ACCESS_DENIED_ERROR_WITH_PASSWORD; Access denied for user '%s'@'%s' (using password: %s)
+		10927, // ER_ACCESS_DENIED_FOR_USER_ACCOUNT_LOCKED; Access denied for user '%s'@'%s'. Account is locked.
+		11192, // ER_FIREWALL_ACCESS_DENIED; ACCESS DENIED for '%s'. Reason: %s Statement: %s
+		13525: // ER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK; Access denied for user '%s'@'%s'. Account is blocked for %s day(s) (%s day(s) remaining) due to %u consecutive failed logins. Use FLUSH PRIVILEGES or ALTER USER to reset.
+		return codes.PermissionDenied, true
+
+	case 1037, // ER_OUTOFMEMORY; Out of memory; restart server and try again (needed %d bytes)
+		1038, // ER_OUT_OF_SORTMEMORY; Out of sort memory, consider increasing server sort buffer size
+		1040, // ER_CON_COUNT_ERROR; Too many connections
+		1041, // ER_OUT_OF_RESOURCES; Out of memory; check if mysqld or some other process uses all available memory; if not, you may have to use 'ulimit' to allow mysqld to use more memory or you can add more swap space
+		1129, // ER_HOST_IS_BLOCKED; Host '%s' is blocked because of many connection errors; unblock with 'mysqladmin flush-hosts'
+		1197, // ER_TRANS_CACHE_FULL; Multi-statement transaction required more than 'max_binlog_cache_size' bytes of storage; increase this mysqld variable and try again
+		1203, // ER_TOO_MANY_USER_CONNECTIONS; User %s already has more than 'max_user_connections' active connections
+		1206, // ER_LOCK_TABLE_FULL; The total number of locks exceeds the lock table size
+		1226, // ER_USER_LIMIT_REACHED; User '%s' has exceeded the '%s' resource (current value: %ld)
+		1461: // ER_MAX_PREPARED_STMT_COUNT_REACHED; Can't create more than max_prepared_stmt_count statements (current value: %lu)
+		return codes.ResourceExhausted, true
+
+	case 1213: // ER_LOCK_DEADLOCK; Deadlock found when trying to get lock; try restarting transaction
+		return codes.Aborted, true
+
+	case 1148, // ER_NOT_ALLOWED_COMMAND; The used command is not allowed with this MySQL version
+		1178, // ER_CHECK_NOT_IMPLEMENTED; The storage engine for the table doesn't support %s
+		1235, // ER_NOT_SUPPORTED_YET; This version of MySQL doesn't yet support '%s'
+		1295: // ER_UNSUPPORTED_PS; This command is not supported in the prepared statement protocol yet
+		return codes.Unimplemented, true
+
+	case 1053, // ER_SERVER_SHUTDOWN; Server shutdown in progress
+		1077, // ER_NORMAL_SHUTDOWN; %s: Normal shutdown
+		1079, // ER_SHUTDOWN_COMPLETE; %s: Shutdown complete
+		1080, // ER_FORCING_CLOSE; %s: Forcing close of thread %ld user: '%s'
+		1194, // ER_CRASHED_ON_USAGE; Table '%s' is marked as crashed and should be repaired
+		1195: // ER_CRASHED_ON_REPAIR; Table '%s' is marked as crashed and last (automatic?) repair failed
+		return codes.Unavailable, true
+
+	case 1131: // ER_PASSWORD_ANONYMOUS_USER; You are using MySQL as an anonymous user and anonymous users are not allowed to change passwords
+		return codes.Unauthenticated, true
+	}
+	return codes.Unknown, false
+}
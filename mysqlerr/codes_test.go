@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+// mysqlCodeMap returns the generated mappings as a map.
+func mysqlCodeMap() map[uint16]codes.Code {
+	m := make(map[uint16]codes.Code)
+	for n := range math.MaxUint16 + 1 {
+		if code, ok := mysqlCode(uint16(n)); ok {
+			m[uint16(n)] = code
+		}
+	}
+	return m
+}
+
+func TestMySQLCode(t *testing.T) {
+	for n, want := range map[uint16]codes.Code{
+		1062: codes.AlreadyExists,
+		1205: codes.DeadlineExceeded,
+		1213: codes.Aborted,
+		1317: codes.Canceled,
+		3819: codes.InvalidArgument,
+	} {
+		if got, ok := mysqlCode(n); !ok || got != want {
+			t.Errorf("mysqlCode(%d): got (%v, %v); want (%v, true)", n, got, ok, want)
+		}
+	}
+	if got, ok := mysqlCode(1000); ok {
+		t.Errorf("mysqlCode(1000): got (%v, %v); want (Unknown, false)", got, ok)
+	}
+}
+
+func BenchmarkMySQLCode(b *testing.B) {
+	m := mysqlCodeMap()
+	var numbers []uint16
+	for n := range m {
+		numbers = append(numbers, n, n+1)
+	}
+	b.Run("map", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			_ = m[numbers[i%len(numbers)]]
+		}
+	})
+	b.Run("switch", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			_, _ = mysqlCode(numbers[i%len(numbers)])
+		}
+	})
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDialectErrorCoders(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{
+			err: &mysql.MySQLError{
+				Number:  1105,
+				Message: "target: ks.-80.primary: vttablet: rpc error: code = ResourceExhausted desc = pool timed out",
+			},
+			code: codes.ResourceExhausted,
+		},
+		{
+			err: &mysql.MySQLError{
+				Number:  1105,
+				Message: "vttablet: rpc error: code = OK desc = unexpected",
+			},
+			code: codes.Unknown,
+		},
+		{
+			err:  &mysql.MySQLError{Number: 1290, Message: "The MySQL server is running with the --read-only option"},
+			code: codes.Unavailable,
+		},
+		{
+			err:  &mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'PRIMARY'"},
+			code: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		if got := VitessErrorCoder().ErrorCode(tt.err); got != tt.code {
+			t.Errorf("VitessErrorCoder(%v): got %v; want %v", tt.err, got, tt.code)
+		}
+	}
+	if got := TiDBErrorCoder().ErrorCode(&mysql.MySQLError{Number: 9005}); got != codes.Unavailable {
+		t.Errorf("TiDBErrorCoder: got %v; want %v", got, codes.Unavailable)
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	t.Run("mysql", func(t *testing.T) {
+		errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+			{Name: "duplicate entry", Err: &mysql.MySQLError{Number: 1062}, Want: codes.AlreadyExists},
+			{Name: "lock wait timeout", Err: &mysql.MySQLError{Number: 1205}, Want: codes.DeadlineExceeded},
+			{Name: "invalid conn", Err: mysql.ErrInvalidConn, Want: codes.Unavailable},
+		})
+	})
+	t.Run("vitess", func(t *testing.T) {
+		errcodetest.TestErrorCoder(t, VitessErrorCoder(), []errcodetest.Case{
+			{Name: "read only", Err: &mysql.MySQLError{Number: 1290, Message: "The MySQL server is running with the --read-only option"}, Want: codes.Unavailable},
+			{Name: "vttablet", Err: &mysql.MySQLError{Number: 1105, Message: "vttablet: rpc error: code = ResourceExhausted desc = pool timed out"}, Want: codes.ResourceExhausted},
+		})
+	})
+	t.Run("tidb", func(t *testing.T) {
+		errcodetest.TestErrorCoder(t, TiDBErrorCoder(), []errcodetest.Case{
+			{Name: "region unavailable", Err: &mysql.MySQLError{Number: 9005}, Want: codes.Unavailable},
+		})
+	})
+}
@@ -6,105 +6,124 @@
 
 // Package mysqlerr provides the ability to extract the status code from MySQL errors
 // from the github.com/go-sql-driver/mysql package.
+//
+// Databases that speak the MySQL protocol but have their own error numbers,
+// such as Vitess and TiDB, have dedicated ErrorCoders.
 package mysqlerr
 
 import (
-	"errors"
+	"database/sql/driver"
 
 	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/sqlstate"
 	"github.com/go-sql-driver/mysql"
 	"google.golang.org/grpc/codes"
 )
 
-var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+//go:generate go run -C .. ./cmd/gentables -vendor=mysql -policy=mysqlerr/codes.txt -out=mysqlerr/codes_gen.go -pkg=mysqlerr -func=mysqlCode
+
+var errorCoder = &coder{mapping: mapping}
 
 // ErrorCoder return the MySQL ErrorCoder.
 func ErrorCoder() errcode.ErrorCoder {
 	return errorCoder
 }
 
-// SEE: https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
-
-var mysqlCodes = map[uint16]codes.Code{
-	1317: codes.Canceled, // ER_QUERY_INTERRUPTED; Query execution was interrupted
-
-	1149: codes.InvalidArgument, // ER_SYNTAX_ERROR; You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version for the right syntax to use
-
-	1205: codes.DeadlineExceeded, // ER_LOCK_WAIT_TIMEOUT; Lock wait timeout exceeded; try restarting transaction
-
-	1008: codes.NotFound, // ER_DB_DROP_EXISTS; Can't drop database '%s'; database doesn't exist
-	1017: codes.NotFound, // ER_FILE_NOT_FOUND; Can't find file: '%s' (errno: %d - %s)
-	1031: codes.NotFound, // ER_KEY_NOT_FOUND; Can't find record in '%s'
-	1049: codes.NotFound, // ER_BAD_DB_ERROR; Unknown database '%s'
-	1051: codes.NotFound, // ER_BAD_TABLE_ERROR; Unknown table '%s'
-	1106: codes.NotFound, // ER_UNKNOWN_PROCEDURE; Unknown procedure '%s'
-	1109: codes.NotFound, // ER_UNKNOWN_TABLE; Unknown table '%s' in %s
-	1133: codes.NotFound, // ER_PASSWORD_NO_MATCH; Can't find any matching row in the user table
-	1146: codes.NotFound, // ER_NO_SUCH_TABLE; Table '%s.%s' doesn't exist
-	1176: codes.NotFound, // ER_KEY_DOES_NOT_EXITS; Key '%s' doesn't exist in table '%s'
-	1305: codes.NotFound, // ER_SP_DOES_NOT_EXIST; %s %s does not exist
-
-	1007: codes.AlreadyExists, // ER_DB_CREATE_EXISTS; Can't create database '%s'; database exists
-	1022: codes.AlreadyExists, // ER_DUP_KEY; Can't write; duplicate key in table '%s'
-	1050: codes.AlreadyExists, // ER_TABLE_EXISTS_ERROR;Table '%s' already exists
-	1086: codes.AlreadyExists, // ER_FILE_EXISTS_ERROR; File '%s' already exists
-	1169: codes.AlreadyExists, // ER_DUP_UNIQUE; Can't write, because of unique constraint, to table '%s'
-	1304: codes.AlreadyExists, // ER_SP_ALREADY_EXISTS; %s %s already exists
-
-	1044:  codes.PermissionDenied, // ER_DBACCESS_DENIED_ERROR; Access denied for user '%s'@'%s' to database '%s'
-	1045:  codes.PermissionDenied, // ER_ACCESS_DENIED_ERROR; Access denied for user '%s'@'%s' (using password: %s)
-	1130:  codes.PermissionDenied, // ER_HOST_NOT_PRIVILEGED; Host '%s' is not allowed to connect to this MySQL server
-	1132:  codes.PermissionDenied, // ER_PASSWORD_NOT_ALLOWED; You must have privileges to update tables in the mysql database to be able to change passwords for others
-	1142:  codes.PermissionDenied, // ER_TABLEACCESS_DENIED_ERROR; %s command denied to user '%s'@'%s' for table '%s'
-	1143:  codes.PermissionDenied, // ER_COLUMNACCESS_DENIED_ERROR; %s command denied to user '%s'@'%s' for column '%s' in table '%s'
-	1227:  codes.PermissionDenied, // ER_SPECIFIC_ACCESS_DENIED_ERROR; SQLSTATE: Access denied; you need (at least one of) the %s privilege(s) for this operation
-	1698:  codes.PermissionDenied, // ER_ACCESS_DENIED_NO_PASSWORD_ERROR; Access denied for user '%s'@'%s'
-	3118:  codes.PermissionDenied, // ER_ACCOUNT_HAS_BEEN_LOCKED; Access denied for user '%s'@'%s'. Account is locked.
-	3879:  codes.PermissionDenied, // ER_DB_ACCESS_DENIED; Access denied for AuthId `%s`@`%s` to database '%s
-	3955:  codes.PermissionDenied, // ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK; Access denied for user '%s'@'%s'. Account is blocked for %s day(s) (%s day(s) remaining) due to %u consecutive failed logins.
-	10926: codes.PermissionDenied, // ER_ACCESS_DENIED_ERROR_WITH_PASSWORD; Access denied for user '%s'@'%s' (using password: %s)
-	10927: codes.PermissionDenied, // ER_ACCESS_DENIED_FOR_USER_ACCOUNT_LOCKED; Access denied for user '%s'@'%s'. Account is locked.
-	11192: codes.PermissionDenied, // ER_FIREWALL_ACCESS_DENIED; ACCESS DENIED for '%s'. Reason: %s Statement: %s
-	13525: codes.PermissionDenied, // ER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK; Access denied for user '%s'@'%s'. Account is blocked for %s day(s) (%s day(s) remaining) due to %u consecutive failed logins. Use FLUSH PRIVILEGES or ALTER USER to reset.
-
-	1037: codes.ResourceExhausted, // ER_OUTOFMEMORY; Out of memory; restart server and try again (needed %d bytes)
-	1038: codes.ResourceExhausted, // ER_OUT_OF_SORTMEMORY; Out of sort memory, consider increasing server sort buffer size
-	1040: codes.ResourceExhausted, // ER_CON_COUNT_ERROR; Too many connections
-	1041: codes.ResourceExhausted, // ER_OUT_OF_RESOURCES; Out of memory; check if mysqld or some other process uses all available memory; if not, you may have to use 'ulimit' to allow mysqld to use more memory or you can add more swap space
-	1129: codes.ResourceExhausted, // ER_HOST_IS_BLOCKED; Host '%s' is blocked because of many connection errors; unblock with 'mysqladmin flush-hosts'
-	1197: codes.ResourceExhausted, // ER_TRANS_CACHE_FULL; Multi-statement transaction required more than 'max_binlog_cache_size' bytes of storage; increase this mysqld variable and try again
-	1203: codes.ResourceExhausted, // ER_TOO_MANY_USER_CONNECTIONS; User %s already has more than 'max_user_connections' active connections
-	1206: codes.ResourceExhausted, // ER_LOCK_TABLE_FULL; The total number of locks exceeds the lock table size
-	1226: codes.ResourceExhausted, // ER_USER_LIMIT_REACHED; User '%s' has exceeded the '%s' resource (current value: %ld)
-	1461: codes.ResourceExhausted, // ER_MAX_PREPARED_STMT_COUNT_REACHED; Can't create more than max_prepared_stmt_count statements (current value: %lu)
-
-	1213: codes.Aborted, // ER_LOCK_DEADLOCK; Deadlock found when trying to get lock; try restarting transaction
-
-	1148: codes.Unimplemented, // ER_NOT_ALLOWED_COMMAND; The used command is not allowed with this MySQL version
-	1178: codes.Unimplemented, // ER_CHECK_NOT_IMPLEMENTED; The storage engine for the table doesn't support %s
-	1235: codes.Unimplemented, // ER_NOT_SUPPORTED_YET; This version of MySQL doesn't yet support '%s'
-	1295: codes.Unimplemented, // ER_UNSUPPORTED_PS; This command is not supported in the prepared statement protocol yet
-
-	1053: codes.Unavailable, // ER_SERVER_SHUTDOWN; Server shutdown in progress
-	1077: codes.Unavailable, // ER_NORMAL_SHUTDOWN; %s: Normal shutdown
-	1079: codes.Unavailable, // ER_SHUTDOWN_COMPLETE; %s: Shutdown complete
-	1080: codes.Unavailable, // ER_FORCING_CLOSE; %s: Forcing close of thread %ld user: '%s'
-	1194: codes.Unavailable, // ER_CRASHED_ON_USAGE; Table '%s' is marked as crashed and should be repaired
-	1195: codes.Unavailable, // ER_CRASHED_ON_REPAIR; Table '%s' is marked as crashed and last (automatic?) repair failed
-
-	1131: codes.Unauthenticated, // ER_PASSWORD_ANONYMOUS_USER; You are using MySQL as an anonymous user and anonymous users are not allowed to change passwords
-}
-
 // ErrorCode returns the gRPC code associated with the given error
-// if it contains a mysql.MySQLError.
+// if it contains a mysql.MySQLError or a known client error of the driver,
+// such as mysql.ErrInvalidConn or driver.ErrBadConn. Errors whose numbers
+// aren't recognized fall back to the class of their SQLSTATE, such as "23"
+// for integrity constraint violations or "08" for connection exceptions.
 func ErrorCode(err error) codes.Code {
+	return errorCoder.ErrorCode(err)
+}
+
+type coder struct {
+	numbers map[uint16]codes.Code // overrides of the generated mappings
+	mapping *sqlstate.Mapping
+}
+
+func (c *coder) ErrorCode(err error) codes.Code {
 	if err == nil {
 		return codes.OK
 	}
-	if e, ok := err.(*mysql.MySQLError); ok || errors.As(err, &e) {
-		if code, ok := mysqlCodes[e.Number]; ok {
+	if e, ok := errcode.As[*mysql.MySQLError](err); ok {
+		if code, ok := c.numbers[e.Number]; ok {
+			return code
+		}
+		if code, ok := mysqlCode(e.Number); ok {
 			return code
 		}
+		if e.SQLState != [5]byte{} {
+			return c.mapping.Code(string(e.SQLState[:]))
+		}
+		return codes.Unknown
+	}
+	return sentinelCoder.ErrorCode(err)
+}
+
+// SEE: https://github.com/go-sql-driver/mysql/blob/master/errors.go
+
+var errorCodes = map[error]codes.Code{
+	driver.ErrBadConn:    codes.Unavailable,
+	mysql.ErrInvalidConn: codes.Unavailable,
+
+	mysql.ErrMalformPkt:  codes.Internal,
+	mysql.ErrPktSync:     codes.Internal,
+	mysql.ErrPktSyncMul:  codes.Internal,
+	mysql.ErrBusyBuffer:  codes.Internal,
+	mysql.ErrPktTooLarge: codes.ResourceExhausted,
+
+	mysql.ErrNoTLS:             codes.FailedPrecondition,
+	mysql.ErrCleartextPassword: codes.FailedPrecondition,
+	mysql.ErrNativePassword:    codes.FailedPrecondition,
+	mysql.ErrOldPassword:       codes.FailedPrecondition,
+	mysql.ErrUnknownPlugin:     codes.Unimplemented,
+	mysql.ErrOldProtocol:       codes.Unimplemented,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// SEE: https://dev.mysql.com/doc/mysql-errors/8.0/en/error-message-elements.html
+
+// NOTE: MySQL reports most errors without a standard SQLSTATE with the generic
+// "HY000", which is intentionally left unmapped.
+var mapping = sqlstate.Standard().Extend(map[string]codes.Code{
+	"70100": codes.Canceled,           // ER_QUERY_INTERRUPTED
+	"HY001": codes.ResourceExhausted,  // memory allocation error
+	"XAE04": codes.NotFound,           // ER_XAER_NOTA; Unknown XID
+	"XAE07": codes.FailedPrecondition, // ER_XAER_RMFAIL; command cannot be executed in the current XA state
+})
+
+// SQLStateCode returns the gRPC code associated with the given SQLSTATE.
+// It's used by ErrorCode for errors whose numbers aren't recognized.
+func SQLStateCode(state string) codes.Code {
+	return mapping.Code(state)
+}
+
+// Mapping returns the MySQL SQLSTATE mapping, which extends the standard mapping.
+func Mapping() *sqlstate.Mapping {
+	return mapping
+}
+
+// Retryable reports whether the error is a transient failure after which
+// the whole transaction may be retried: a deadlock (1213), a lock wait timeout
+// (1205), or a lost connection, such as mysql.ErrInvalidConn, driver.ErrBadConn,
+// or a server that has gone away (2006) or was lost (2013) through a proxy.
+//
+// A statement that failed because its connection was lost may or may not
+// have been committed, so only transactions should be retried.
+func Retryable(err error) bool {
+	if e, ok := errcode.As[*mysql.MySQLError](err); ok {
+		switch e.Number {
+		case 1205, // ER_LOCK_WAIT_TIMEOUT
+			1213, // ER_LOCK_DEADLOCK
+			1927, // ER_CONNECTION_KILLED
+			2006, // CR_SERVER_GONE_ERROR
+			2013: // CR_SERVER_LOST
+			return true
+		}
+		return false
 	}
-	return codes.Unknown
+	return errcode.Is(err, mysql.ErrInvalidConn) || errcode.Is(err, driver.ErrBadConn)
 }
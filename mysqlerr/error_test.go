@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDriverErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{driver.ErrBadConn, codes.Unavailable},
+		{fmt.Errorf("exec: %w", mysql.ErrInvalidConn), codes.Unavailable},
+		{mysql.ErrMalformPkt, codes.Internal},
+		{mysql.ErrPktTooLarge, codes.ResourceExhausted},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.code {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.code)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&mysql.MySQLError{Number: 1213}, true},
+		{fmt.Errorf("commit: %w", &mysql.MySQLError{Number: 1205}), true},
+		{&mysql.MySQLError{Number: 1062}, false},
+		{mysql.ErrInvalidConn, true},
+		{driver.ErrBadConn, true},
+		{mysql.ErrMalformPkt, false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := Retryable(tt.err); got != tt.want {
+			t.Errorf("Retryable(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
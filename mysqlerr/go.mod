@@ -3,7 +3,7 @@ module bursavich.dev/errcode/mysqlerr
 go 1.24.0
 
 require (
-	bursavich.dev/errcode v0.1.0
+	bursavich.dev/errcode v0.2.0
 	github.com/go-sql-driver/mysql v1.9.2
 	google.golang.org/grpc v1.72.2
 )
@@ -11,4 +11,8 @@ require (
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
 )
+
+replace bursavich.dev/errcode => ../
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"maps"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// An Option configures an ErrorCoder.
+type Option func(*coder)
+
+// WithCodes returns an Option that maps the given error numbers to codes,
+// overriding or extending the default mappings. For example, a lock wait
+// timeout (1205) may be treated as Aborted instead of DeadlineExceeded:
+//
+//	mysqlerr.WithCodes(map[uint16]codes.Code{1205: codes.Aborted})
+func WithCodes(numbers map[uint16]codes.Code) Option {
+	return func(c *coder) {
+		if c.numbers == nil {
+			c.numbers = make(map[uint16]codes.Code, len(numbers))
+		}
+		maps.Copy(c.numbers, numbers)
+	}
+}
+
+// WithSQLStates returns an Option that overrides or extends the SQLSTATE
+// mappings used for errors whose numbers aren't recognized. Each key must be
+// either a five character SQLSTATE or a two character class, as with
+// sqlstate.Mapping.Extend.
+func WithSQLStates(states map[string]codes.Code) Option {
+	return func(c *coder) {
+		c.mapping = c.mapping.Extend(states)
+	}
+}
+
+// ErrorCoderWithOptions returns a MySQL ErrorCoder configured by the given
+// options. The package's default mappings aren't modified.
+func ErrorCoderWithOptions(opts ...Option) errcode.ErrorCoder {
+	c := &coder{mapping: mapping}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoderWithOptions(t *testing.T) {
+	coder := ErrorCoderWithOptions(
+		WithCodes(map[uint16]codes.Code{1205: codes.Aborted, 9999: codes.NotFound}),
+		WithSQLStates(map[string]codes.Code{"HY000": codes.Internal}),
+	)
+	tests := []struct {
+		err         error
+		code        codes.Code
+		defaultCode codes.Code
+	}{
+		{&mysql.MySQLError{Number: 1205}, codes.Aborted, codes.DeadlineExceeded},
+		{&mysql.MySQLError{Number: 9999}, codes.NotFound, codes.Unknown},
+		{&mysql.MySQLError{Number: 9998, SQLState: [5]byte{'H', 'Y', '0', '0', '0'}}, codes.Internal, codes.Unknown},
+		{fmt.Errorf("query: %w", &mysql.MySQLError{Number: 1062}), codes.AlreadyExists, codes.AlreadyExists},
+	}
+	for _, tt := range tests {
+		if got := coder.ErrorCode(tt.err); got != tt.code {
+			t.Errorf("ErrorCoderWithOptions(%v): got %v; want %v", tt.err, got, tt.code)
+		}
+		if got := ErrorCode(tt.err); got != tt.defaultCode {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.defaultCode)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// SEE: https://docs.pingcap.com/tidb/stable/error-codes
+
+var tidbCodes = map[uint16]codes.Code{
+	8002: codes.Aborted, // ErrSelectForUpdateConflict; SELECT FOR UPDATE write conflict
+	8005: codes.Aborted, // ErrWriteConflictInTiDB; Transactions in TiDB encounter conflicts
+	8022: codes.Aborted, // ErrTxnRetryable; Error: KV error safe to retry
+	8027: codes.Aborted, // ErrInfoSchemaExpired; Information schema is out of date
+	8028: codes.Aborted, // ErrInfoSchemaChanged; Information schema is changed during the execution of the statement
+	9004: codes.Aborted, // ErrResolveLockTimeout; Resolve lock timeout
+	9007: codes.Aborted, // ErrWriteConflict; Write conflict
+
+	8001: codes.ResourceExhausted, // ErrMemExceedThreshold; The memory used by the request exceeds the threshold limit
+	8004: codes.ResourceExhausted, // ErrTxnTooLarge; Transaction is too large
+
+	9001: codes.Unavailable, // ErrPDServerTimeout; PD server timeout
+	9002: codes.Unavailable, // ErrTiKVServerTimeout; TiKV server timeout
+	9003: codes.Unavailable, // ErrTiKVServerBusy; TiKV server is busy
+	9005: codes.Unavailable, // ErrRegionUnavailable; Region is unavailable
+
+	9006: codes.FailedPrecondition, // ErrGCTooEarly; GC life time is shorter than transaction duration
+}
+
+var tidbErrorCoder = ErrorCoderWithOptions(WithCodes(tidbCodes))
+
+// TiDBErrorCoder returns an ErrorCoder for errors from TiDB, which speaks the
+// MySQL protocol. It extends the MySQL ErrorCoder with TiDB's error numbers,
+// such as those of write conflicts and unavailable regions.
+func TiDBErrorCoder() errcode.ErrorCoder {
+	return tidbErrorCoder
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package mysqlerr
+
+import (
+	"regexp"
+
+	"bursavich.dev/errcode"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+)
+
+// SEE: https://github.com/vitessio/vitess/blob/main/go/mysql/sqlerror/constants.go
+// SEE: https://github.com/vitessio/vitess/blob/main/go/vt/vterrors/code.go
+
+var vitessCodes = map[uint16]codes.Code{
+	1179: codes.FailedPrecondition, // ER_CANT_DO_THIS_DURING_AN_TRANSACTION; You are not allowed to execute this command in a transaction
+	1290: codes.Unavailable,        // ER_OPTION_PREVENTS_STATEMENT; The MySQL server is running with the --read-only option (e.g. during a reparent)
+	1792: codes.Unavailable,        // ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION; Cannot execute statement in a READ ONLY transaction
+}
+
+var vitessErrorCoder = &vitessCoder{ErrorCoderWithOptions(WithCodes(vitessCodes))}
+
+// VitessErrorCoder returns an ErrorCoder for errors from Vitess, which speaks
+// the MySQL protocol. Vitess errors carry the canonical gRPC code of the
+// underlying vtgate or vttablet error in their messages, such as
+// "vttablet: rpc error: code = Aborted desc = ...", which takes precedence
+// over the error number. Otherwise, it extends the MySQL ErrorCoder with
+// the numbers that Vitess uses to report read-only tablets during failovers.
+func VitessErrorCoder() errcode.ErrorCoder {
+	return vitessErrorCoder
+}
+
+var vitessCodePattern = regexp.MustCompile(`code = (\w+) desc = `)
+
+type vitessCoder struct {
+	coder errcode.ErrorCoder
+}
+
+func (c *vitessCoder) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*mysql.MySQLError](err); ok {
+		if m := vitessCodePattern.FindStringSubmatch(e.Message); m != nil {
+			if code, err := errcode.ParseCode(m[1]); err == nil && code != codes.OK && code != codes.Unknown {
+				return code
+			}
+		}
+	}
+	return c.coder.ErrorCode(err)
+}
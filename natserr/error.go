@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package natserr provides the ability to extract the status code from NATS errors
+// from the github.com/nats-io/nats.go package, including JetStream API errors.
+package natserr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the NATS ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://github.com/nats-io/nats-server/blob/main/server/errors.json
+
+var jetStreamCodes = map[nats.ErrorCode]codes.Code{
+	10003: codes.InvalidArgument, // JSBadRequestErr; bad request
+	10136: codes.InvalidArgument, // JSConsumerDuplicateFilterSubjects; consumer cannot have both FilterSubject and FilterSubjects specified
+	10138: codes.InvalidArgument, // JSConsumerOverlappingSubjectFilters; consumer subject filters cannot overlap
+	10139: codes.InvalidArgument, // JSConsumerEmptyFilter; consumer filter in FilterSubjects cannot be empty
+	10054: codes.InvalidArgument, // JSStreamMessageExceedsMaximumErr; message size exceeds maximum allowed
+
+	10014: codes.NotFound, // JSConsumerNotFoundErr; consumer not found
+	10037: codes.NotFound, // JSNoMessageFoundErr; no message found
+	10059: codes.NotFound, // JSStreamNotFoundErr; stream not found
+
+	10013: codes.AlreadyExists, // JSConsumerNameExistErr; consumer name already in use
+	10058: codes.AlreadyExists, // JSStreamNameExistErr; stream name already in use with a different configuration
+	10105: codes.AlreadyExists, // JSConsumerAlreadyExists; consumer already exists
+
+	10002: codes.ResourceExhausted, // JSAccountResourcesExceededErr; resource limits exceeded for account
+	10023: codes.ResourceExhausted, // JSInsufficientResourcesErr; insufficient resources
+	10026: codes.ResourceExhausted, // JSMaximumConsumersLimitErr; maximum consumers limit reached
+	10027: codes.ResourceExhausted, // JSMaximumStreamsLimitErr; maximum number of streams reached
+	10028: codes.ResourceExhausted, // JSMemoryResourcesExceededErr; insufficient memory resources available
+	10047: codes.ResourceExhausted, // JSStorageResourcesExceededErr; insufficient storage resources available
+
+	10039: codes.FailedPrecondition, // JSNotEnabledForAccountErr; JetStream not enabled for account
+	10071: codes.FailedPrecondition, // JSStreamWrongLastSequenceErrF; wrong last sequence
+	10076: codes.FailedPrecondition, // JSNotEnabledErr; JetStream not enabled
+
+	10008: codes.Unavailable, // JSClusterNotAvailErr; JetStream system temporarily unavailable
+}
+
+var errorCodes = map[error]codes.Code{
+	nats.ErrBadSubject:   codes.InvalidArgument,
+	nats.ErrBadQueueName: codes.InvalidArgument,
+	nats.ErrInvalidArg:   codes.InvalidArgument,
+	nats.ErrInvalidMsg:   codes.InvalidArgument,
+	nats.ErrMaxPayload:   codes.InvalidArgument,
+
+	nats.ErrTimeout:             codes.DeadlineExceeded,
+	nats.ErrDrainTimeout:        codes.DeadlineExceeded,
+	nats.ErrAsyncPublishTimeout: codes.DeadlineExceeded,
+
+	nats.ErrNoMatchingStream: codes.NotFound,
+
+	nats.ErrAuthorization:       codes.PermissionDenied,
+	nats.ErrPermissionViolation: codes.PermissionDenied,
+
+	nats.ErrSlowConsumer:                  codes.ResourceExhausted,
+	nats.ErrMaxConnectionsExceeded:        codes.ResourceExhausted,
+	nats.ErrMaxAccountConnectionsExceeded: codes.ResourceExhausted,
+	nats.ErrMaxSubscriptionsExceeded:      codes.ResourceExhausted,
+	nats.ErrReconnectBufExceeded:          codes.ResourceExhausted,
+	nats.ErrTooManyStalledMsgs:            codes.ResourceExhausted,
+
+	nats.ErrBadSubscription:          codes.FailedPrecondition,
+	nats.ErrMsgAlreadyAckd:           codes.FailedPrecondition,
+	nats.ErrJetStreamPublisherClosed: codes.FailedPrecondition,
+
+	nats.ErrConnectionClosed:       codes.Unavailable,
+	nats.ErrConnectionDraining:     codes.Unavailable,
+	nats.ErrConnectionReconnecting: codes.Unavailable,
+	nats.ErrDisconnected:           codes.Unavailable,
+	nats.ErrNoResponders:           codes.Unavailable,
+	nats.ErrNoServers:              codes.Unavailable,
+	nats.ErrNoStreamResponse:       codes.Unavailable,
+	nats.ErrStaleConnection:        codes.Unavailable,
+
+	nats.ErrAuthExpired:        codes.Unauthenticated,
+	nats.ErrAuthRevoked:        codes.Unauthenticated,
+	nats.ErrAccountAuthExpired: codes.Unauthenticated,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a JetStream *nats.APIError or a known NATS error.
+// Unknown JetStream error codes fall back to the HTTP-like status code.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*nats.APIError](err); ok {
+		if code, ok := jetStreamCodes[e.ErrorCode]; ok {
+			return code
+		}
+		return httperr.ToGRPC(e.Code)
+	}
+	return sentinelCoder.ErrorCode(err)
+}
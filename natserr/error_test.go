@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package natserr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "stream not found", Err: &nats.APIError{Code: 404, ErrorCode: 10059}, Want: codes.NotFound},
+		{Name: "stream exists", Err: &nats.APIError{Code: 400, ErrorCode: 10058}, Want: codes.AlreadyExists},
+		{Name: "wrong last sequence", Err: &nats.APIError{Code: 400, ErrorCode: 10071}, Want: codes.FailedPrecondition},
+		{Name: "unknown api error", Err: &nats.APIError{Code: 503, ErrorCode: 9999}, Want: codes.Unavailable},
+		{Name: "timeout", Err: nats.ErrTimeout, Want: codes.DeadlineExceeded},
+		{Name: "no responders", Err: nats.ErrNoResponders, Want: codes.Unavailable},
+		{Name: "slow consumer", Err: nats.ErrSlowConsumer, Want: codes.ResourceExhausted},
+		{Name: "authorization", Err: nats.ErrAuthorization, Want: codes.PermissionDenied},
+		{Name: "auth expired", Err: nats.ErrAuthExpired, Want: codes.Unauthenticated},
+	})
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !plan9
+
+package neterr
+
+import "syscall"
+
+var connErrnos = []error{
+	syscall.ECONNABORTED,
+	syscall.ECONNREFUSED,
+	syscall.ECONNRESET,
+	syscall.EHOSTUNREACH,
+	syscall.ENETDOWN,
+	syscall.ENETRESET,
+	syscall.ENETUNREACH,
+	syscall.EPIPE,
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package neterr provides the ability to extract the status code from errors
+// from the net package.
+package neterr
+
+import (
+	"net"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the net ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an error from the net package.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if errcode.Is(err, net.ErrClosed) {
+		return codes.Unavailable
+	}
+	if e, ok := errcode.As[*net.DNSError](err); ok {
+		switch {
+		case e.IsNotFound:
+			return codes.NotFound
+		case e.IsTimeout:
+			return codes.DeadlineExceeded
+		}
+		return codes.Unavailable
+	}
+	if e, ok := errcode.As[net.Error](err); ok && e.Timeout() {
+		return codes.DeadlineExceeded
+	}
+	if isConnError(err) {
+		return codes.Unavailable
+	}
+	if _, ok := errcode.As[*net.AddrError](err); ok {
+		return codes.InvalidArgument
+	}
+	if _, ok := errcode.As[*net.ParseError](err); ok {
+		return codes.InvalidArgument
+	}
+	if _, ok := errcode.As[net.UnknownNetworkError](err); ok {
+		return codes.InvalidArgument
+	}
+	if _, ok := errcode.As[net.InvalidAddrError](err); ok {
+		return codes.InvalidArgument
+	}
+	return codes.Unknown
+}
+
+// isConnError reports whether the error is a connection failure,
+// such as a refused or reset connection or an unreachable host.
+func isConnError(err error) bool {
+	for _, target := range connErrnos {
+		if errcode.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !plan9
+
+package neterr
+
+import (
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "closed", Err: net.ErrClosed, Want: codes.Unavailable},
+		{Name: "dns not found", Err: &net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}, Want: codes.NotFound},
+		{Name: "dns timeout", Err: &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true}, Want: codes.DeadlineExceeded},
+		{Name: "dns", Err: &net.DNSError{Err: "server misbehaving", Name: "example.com"}, Want: codes.Unavailable},
+		{Name: "timeout", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}, Want: codes.DeadlineExceeded},
+		{Name: "refused", Err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, Want: codes.Unavailable},
+		{Name: "reset", Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, Want: codes.Unavailable},
+		{Name: "addr", Err: &net.AddrError{Err: "missing port in address", Addr: "localhost"}, Want: codes.InvalidArgument},
+		{Name: "parse", Err: &net.ParseError{Type: "IP address", Text: "bogus"}, Want: codes.InvalidArgument},
+		{Name: "unknown network", Err: net.UnknownNetworkError("udp7"), Want: codes.InvalidArgument},
+	})
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package oserr
+
+import (
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Plan 9 reports errors as strings, so there are no errno values to map.
+var errnoCodes map[syscall.Errno]codes.Code
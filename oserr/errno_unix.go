@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build unix || js || wasip1
+
+package oserr
+
+import (
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+)
+
+var errnoCodes = makeErrnoCodes(map[codes.Code][]syscall.Errno{
+	codes.Canceled: {
+		syscall.ECANCELED,
+	},
+	codes.InvalidArgument: {
+		syscall.E2BIG,
+		syscall.EINVAL,
+		syscall.ELOOP,
+		syscall.ENAMETOOLONG,
+	},
+	codes.DeadlineExceeded: {
+		syscall.ETIMEDOUT,
+	},
+	codes.NotFound: {
+		syscall.ENODEV,
+		syscall.ENOENT,
+		syscall.ENXIO,
+		syscall.ESRCH,
+	},
+	codes.AlreadyExists: {
+		syscall.EEXIST,
+	},
+	codes.PermissionDenied: {
+		syscall.EACCES,
+		syscall.EPERM,
+	},
+	codes.ResourceExhausted: {
+		syscall.EDQUOT,
+		syscall.EMFILE,
+		syscall.EMLINK,
+		syscall.ENFILE,
+		syscall.ENOBUFS,
+		syscall.ENOMEM,
+		syscall.ENOSPC,
+	},
+	codes.FailedPrecondition: {
+		syscall.EBADF,
+		syscall.EISDIR,
+		syscall.ENOTDIR,
+		syscall.ENOTEMPTY,
+		syscall.EROFS,
+		syscall.EXDEV,
+	},
+	codes.Aborted: {
+		syscall.EDEADLK,
+	},
+	codes.OutOfRange: {
+		syscall.EFBIG,
+		syscall.EOVERFLOW,
+		syscall.ERANGE,
+		syscall.ESPIPE,
+	},
+	codes.Unimplemented: {
+		syscall.EAFNOSUPPORT,
+		syscall.ENOSYS,
+		syscall.ENOTSUP,
+		syscall.EOPNOTSUPP,
+		syscall.EPROTONOSUPPORT,
+	},
+	codes.Internal: {
+		syscall.EIO,
+	},
+	codes.Unavailable: {
+		syscall.EADDRINUSE,
+		syscall.EAGAIN,
+		syscall.EBUSY,
+		syscall.ECONNABORTED,
+		syscall.ECONNREFUSED,
+		syscall.ECONNRESET,
+		syscall.EHOSTUNREACH,
+		syscall.EINTR,
+		syscall.ENETDOWN,
+		syscall.ENETRESET,
+		syscall.ENETUNREACH,
+		syscall.ENOTCONN,
+		syscall.EPIPE,
+		syscall.ESTALE,
+	},
+})
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package oserr
+
+import (
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Windows system error codes that aren't defined by the syscall package.
+// SEE: https://learn.microsoft.com/en-us/windows/win32/debug/system-error-codes
+// SEE: https://learn.microsoft.com/en-us/windows/win32/winsock/windows-sockets-error-codes-2
+const (
+	errorTooManyOpenFiles   syscall.Errno = 4
+	errorNotEnoughMemory    syscall.Errno = 8
+	errorOutOfMemory        syscall.Errno = 14
+	errorWriteProtect       syscall.Errno = 19
+	errorSharingViolation   syscall.Errno = 32
+	errorLockViolation      syscall.Errno = 33
+	errorHandleDiskFull     syscall.Errno = 39
+	errorNotSupported       syscall.Errno = 50
+	errorBadNetPath         syscall.Errno = 53
+	errorInvalidParameter   syscall.Errno = 87
+	errorDiskFull           syscall.Errno = 112
+	errorCallNotImplemented syscall.Errno = 120
+	errorSemTimeout         syscall.Errno = 121
+	errorInvalidName        syscall.Errno = 123
+	errorFilenameExcedRange syscall.Errno = 206
+	waitTimeout             syscall.Errno = 258
+	errorCancelled          syscall.Errno = 1223
+	errorConnectionRefused  syscall.Errno = 1225
+	errorNetworkUnreachable syscall.Errno = 1231
+	errorHostUnreachable    syscall.Errno = 1232
+	errorConnectionAborted  syscall.Errno = 1236
+	errorDiskQuotaExceeded  syscall.Errno = 1295
+	errorTimeout            syscall.Errno = 1460
+	wsaeAddrInUse           syscall.Errno = 10048
+	wsaeNetUnreach          syscall.Errno = 10051
+	wsaeTimedOut            syscall.Errno = 10060
+	wsaeConnRefused         syscall.Errno = 10061
+	wsaeHostUnreach         syscall.Errno = 10065
+)
+
+var errnoCodes = makeErrnoCodes(map[codes.Code][]syscall.Errno{
+	codes.Canceled: {
+		errorCancelled,
+		syscall.ERROR_OPERATION_ABORTED,
+	},
+	codes.InvalidArgument: {
+		errorFilenameExcedRange,
+		errorInvalidName,
+		errorInvalidParameter,
+	},
+	codes.DeadlineExceeded: {
+		errorSemTimeout,
+		errorTimeout,
+		waitTimeout,
+		wsaeTimedOut,
+	},
+	codes.NotFound: {
+		errorBadNetPath,
+		syscall.ERROR_ENVVAR_NOT_FOUND,
+		syscall.ERROR_FILE_NOT_FOUND,
+		syscall.ERROR_MOD_NOT_FOUND,
+		syscall.ERROR_NOT_FOUND,
+		syscall.ERROR_PATH_NOT_FOUND,
+		syscall.ERROR_PROC_NOT_FOUND,
+	},
+	codes.AlreadyExists: {
+		syscall.ERROR_ALREADY_EXISTS,
+		syscall.ERROR_FILE_EXISTS,
+	},
+	codes.PermissionDenied: {
+		syscall.ERROR_ACCESS_DENIED,
+		syscall.ERROR_PRIVILEGE_NOT_HELD,
+		syscall.WSAEACCES,
+	},
+	codes.ResourceExhausted: {
+		errorDiskFull,
+		errorDiskQuotaExceeded,
+		errorHandleDiskFull,
+		errorNotEnoughMemory,
+		errorOutOfMemory,
+		errorTooManyOpenFiles,
+	},
+	codes.FailedPrecondition: {
+		errorWriteProtect,
+		syscall.ERROR_DIR_NOT_EMPTY,
+	},
+	codes.Unimplemented: {
+		errorCallNotImplemented,
+		errorNotSupported,
+	},
+	codes.Unavailable: {
+		errorConnectionAborted,
+		errorConnectionRefused,
+		errorHostUnreachable,
+		errorLockViolation,
+		errorNetworkUnreachable,
+		errorSharingViolation,
+		syscall.ERROR_BROKEN_PIPE,
+		syscall.ERROR_NETNAME_DELETED,
+		syscall.WSAECONNABORTED,
+		syscall.WSAECONNRESET,
+		wsaeAddrInUse,
+		wsaeConnRefused,
+		wsaeHostUnreach,
+		wsaeNetUnreach,
+	},
+})
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package oserr provides the ability to extract the status code from operating system errors,
+// including syscall.Errno values wrapped by *os.PathError, *os.LinkError, and *os.SyscallError.
+package oserr
+
+import (
+	"os"
+	"syscall"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the OS ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known syscall.Errno or os package error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[syscall.Errno](err); ok {
+		if code, ok := errnoCodes[e]; ok {
+			return code
+		}
+	}
+	switch {
+	case errcode.Is(err, os.ErrDeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errcode.Is(err, os.ErrClosed), errcode.Is(err, os.ErrProcessDone):
+		return codes.FailedPrecondition
+	case errcode.Is(err, os.ErrNoDeadline):
+		return codes.Unimplemented
+	}
+	return codes.Unknown
+}
+
+func makeErrnoCodes(byCode map[codes.Code][]syscall.Errno) map[syscall.Errno]codes.Code {
+	// NOTE: Some errno names are aliases for the same value on some systems
+	// (e.g. ENOTSUP and EOPNOTSUPP on Linux), which rules out a map literal keyed by errno.
+	m := make(map[syscall.Errno]codes.Code)
+	for code, list := range byCode {
+		for _, errno := range list {
+			m[errno] = code
+		}
+	}
+	return m
+}
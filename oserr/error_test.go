@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build unix
+
+package oserr
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "EACCES", Err: syscall.EACCES, Want: codes.PermissionDenied},
+		{Name: "ENOENT", Err: &os.PathError{Op: "open", Path: "/missing", Err: syscall.ENOENT}, Want: codes.NotFound},
+		{Name: "EEXIST", Err: &os.PathError{Op: "mkdir", Path: "/tmp", Err: syscall.EEXIST}, Want: codes.AlreadyExists},
+		{Name: "ETIMEDOUT", Err: os.NewSyscallError("connect", syscall.ETIMEDOUT), Want: codes.DeadlineExceeded},
+		{Name: "ECONNREFUSED", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED), Want: codes.Unavailable},
+		{Name: "ENOSPC", Err: &os.PathError{Op: "write", Path: "/tmp/f", Err: syscall.ENOSPC}, Want: codes.ResourceExhausted},
+		{Name: "EMFILE", Err: os.NewSyscallError("accept", syscall.EMFILE), Want: codes.ResourceExhausted},
+		{Name: "EINVAL", Err: syscall.EINVAL, Want: codes.InvalidArgument},
+		{Name: "deadline", Err: os.ErrDeadlineExceeded, Want: codes.DeadlineExceeded},
+		{Name: "closed", Err: os.ErrClosed, Want: codes.FailedPrecondition},
+		{Name: "process done", Err: os.ErrProcessDone, Want: codes.FailedPrecondition},
+		{Name: "no deadline", Err: os.ErrNoDeadline, Want: codes.Unimplemented},
+		{Name: "unmapped errno", Err: syscall.Errno(0), Want: codes.Unknown},
+	})
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package otelerr
+
+import (
+	"context"
+	"time"
+
+	"bursavich.dev/errcode"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+)
+
+const meterName = "bursavich.dev/errcode/otelerr"
+
+// A MetricsOption configures Metrics.
+type MetricsOption func(*metricsOptions)
+
+type metricsOptions struct {
+	provider metric.MeterProvider
+	attrs    []attribute.KeyValue
+}
+
+// WithMeterProvider returns a MetricsOption that sets the MeterProvider used
+// to create the instruments. By default, the global MeterProvider is used.
+func WithMeterProvider(provider metric.MeterProvider) MetricsOption {
+	return func(o *metricsOptions) { o.provider = provider }
+}
+
+// WithAttributes returns a MetricsOption that adds the attributes to every
+// measurement, such as an operation's name.
+func WithAttributes(attrs ...attribute.KeyValue) MetricsOption {
+	return func(o *metricsOptions) { o.attrs = append(o.attrs, attrs...) }
+}
+
+// Metrics records the codes of errors with OpenTelemetry instruments:
+// an "errcode.errors" counter of errors and an "errcode.duration" histogram
+// of operations' durations in seconds, both with a CodeKey attribute.
+type Metrics struct {
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+	attrs    []attribute.KeyValue
+}
+
+// NewMetrics returns new Metrics configured by the given options.
+func NewMetrics(opts ...MetricsOption) (*Metrics, error) {
+	o := &metricsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.provider == nil {
+		o.provider = otel.GetMeterProvider()
+	}
+	meter := o.provider.Meter(meterName)
+	errs, err := meter.Int64Counter("errcode.errors",
+		metric.WithDescription("Number of errors by code."),
+		metric.WithUnit("{error}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("errcode.duration",
+		metric.WithDescription("Duration of operations by code."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Metrics{errors: errs, duration: duration, attrs: o.attrs}, nil
+}
+
+// Record records the outcome of an operation that started at the given time
+// and returns the code of the error resolved by the coders. The duration is
+// recorded for every operation, and non-nil errors are counted.
+func (m *Metrics) Record(ctx context.Context, start time.Time, err error, coders ...errcode.ErrorCoder) codes.Code {
+	code := errcode.ResolveError(errcode.ErrorCoders(coders), err)
+	set := m.attributes(code)
+	m.duration.Record(ctx, time.Since(start).Seconds(), set)
+	if err != nil {
+		m.errors.Add(ctx, 1, set)
+	}
+	return code
+}
+
+// Wrap returns an ErrorCoder that resolves codes with the given ErrorCoder
+// and counts each non-nil error by its code.
+//
+// Like promcoder.Wrap, every call increments the counter, so it should wrap
+// the outermost ErrorCoder of a composition and be called once per error.
+func (m *Metrics) Wrap(coder errcode.ErrorCoder) errcode.ErrorCoder {
+	return errcode.FromFunc(func(err error) codes.Code {
+		code := coder.ErrorCode(err)
+		if err != nil {
+			m.errors.Add(context.Background(), 1, m.attributes(code))
+		}
+		return code
+	})
+}
+
+func (m *Metrics) attributes(code codes.Code) metric.MeasurementOption {
+	attrs := make([]attribute.KeyValue, 0, len(m.attrs)+1)
+	attrs = append(attrs, m.attrs...)
+	attrs = append(attrs, CodeKey.Int(int(code)))
+	return metric.WithAttributes(attrs...)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package otelerr
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"bursavich.dev/errcode"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	m, err := NewMetrics(
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithAttributes(attribute.String("operation", "get")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	start := time.Now()
+	m.Record(ctx, start, nil)
+	if got := m.Record(ctx, start, context.Canceled, errcode.ContextErrorCoder()); got != codes.Canceled {
+		t.Errorf("Record: got %v; want %v", got, codes.Canceled)
+	}
+	m.Wrap(errcode.ContextErrorCoder()).ErrorCode(errors.New("boom"))
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+	errs := map[codes.Code]int64{}
+	durations := map[codes.Code]uint64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, md := range sm.Metrics {
+			switch data := md.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					errs[pointCode(t, dp.Attributes)] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					durations[pointCode(t, dp.Attributes)] += dp.Count
+				}
+			}
+		}
+	}
+	if want := map[codes.Code]int64{codes.Canceled: 1, codes.Unknown: 1}; !equal(errs, want) {
+		t.Errorf("errors: got %v; want %v", errs, want)
+	}
+	if want := map[codes.Code]uint64{codes.OK: 1, codes.Canceled: 1}; !equal(durations, want) {
+		t.Errorf("durations: got %v; want %v", durations, want)
+	}
+}
+
+func pointCode(t *testing.T, set attribute.Set) codes.Code {
+	t.Helper()
+	if v, ok := set.Value("operation"); !ok || v.AsString() != "get" {
+		t.Errorf("missing operation attribute: %v", set)
+	}
+	v, ok := set.Value(CodeKey)
+	if !ok {
+		t.Fatalf("missing %s attribute: %v", CodeKey, set)
+	}
+	return codes.Code(v.AsInt64())
+}
+
+func equal[V comparable](a, b map[codes.Code]V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package otelerr provides the ability to record the codes of errors
+// with the go.opentelemetry.io/otel packages.
+package otelerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/grpcerr"
+	"bursavich.dev/errcode/httpmw"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CodeKey is the attribute key of a gRPC code, as defined by the
+// OpenTelemetry semantic conventions for RPC spans.
+const CodeKey = attribute.Key("rpc.grpc.status_code")
+
+// RecordError records the outcome of an operation on the span and returns
+// the code of the error resolved by the coders.
+//
+// If the error is nil, the span's status is set to Ok. Otherwise, the span's
+// status is set to Error with a description built by grpcerr.SanitizeMessage
+// from the message of the error or its gRPC status, so that messages of
+// server faults aren't exported. In both cases, the code is recorded as
+// a CodeKey attribute.
+func RecordError(span trace.Span, err error, coders ...errcode.ErrorCoder) codes.Code {
+	if err == nil {
+		span.SetAttributes(CodeKey.Int(int(codes.OK)))
+		span.SetStatus(otelcodes.Ok, "")
+		return codes.OK
+	}
+	code := errcode.ResolveError(errcode.Compact(coders...), err)
+	span.SetAttributes(CodeKey.Int(int(code)))
+	span.SetStatus(otelcodes.Error, description(code, err))
+	return code
+}
+
+// description returns the sanitized message of the error,
+// or of its gRPC status if it has one.
+func description(code codes.Code, err error) string {
+	// status.FromError uses errors.As, which isn't depth-limited.
+	if errcode.WithinLimits(err) {
+		if s, ok := status.FromError(err); ok {
+			err = errors.New(s.Message())
+		}
+	}
+	return grpcerr.SanitizeMessage(code, err)
+}
+
+// UnaryServerInterceptor returns a unary server interceptor that records
+// the errors returned by handlers on the spans in their contexts.
+// Errors that already have a gRPC status keep their status code.
+//
+// It should be installed after the interceptor that starts spans.
+func UnaryServerInterceptor(coders ...errcode.ErrorCoder) grpc.UnaryServerInterceptor {
+	coder := errcode.Compact(grpcerr.ErrorCoder(), errcode.ErrorCoders(coders))
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		RecordError(trace.SpanFromContext(ctx), err, coder)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a stream server interceptor that records
+// the errors returned by handlers on the spans in their streams' contexts.
+// Errors that already have a gRPC status keep their status code.
+//
+// It should be installed after the interceptor that starts spans.
+func StreamServerInterceptor(coders ...errcode.ErrorCoder) grpc.StreamServerInterceptor {
+	coder := errcode.Compact(grpcerr.ErrorCoder(), errcode.ErrorCoders(coders))
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		RecordError(trace.SpanFromContext(ss.Context()), err, coder)
+		return err
+	}
+}
+
+// HandlerFunc returns an httpmw.HandlerFunc that calls fn and records the
+// error that it returns on the span in the request's context. If no coders
+// are given, the ErrorCoder installed in the request's context is used, such
+// as by httpmw.Handler.
+//
+// The span should be started by middleware that wraps the handler.
+func HandlerFunc(fn httpmw.HandlerFunc, coders ...errcode.ErrorCoder) httpmw.HandlerFunc {
+	var coder errcode.ErrorCoder = errcode.Compact(coders...)
+	return func(w http.ResponseWriter, r *http.Request) error {
+		err := fn(w, r)
+		c := coder
+		if len(coders) == 0 {
+			if fc, ok := errcode.FromContext(r.Context()); ok {
+				c = fc
+			}
+		}
+		RecordError(trace.SpanFromContext(r.Context()), err, c)
+		return err
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package otelerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httpmw"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTracer() (*tracetest.SpanRecorder, *sdktrace.TracerProvider) {
+	rec := tracetest.NewSpanRecorder()
+	return rec, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+}
+
+func TestRecordError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		status otelcodes.Code
+		desc   string
+	}{
+		{"ok", nil, codes.OK, otelcodes.Ok, ""},
+		{"client fault", fmt.Errorf("open: %w", fs.ErrNotExist), codes.NotFound, otelcodes.Error, "open: file does not exist"},
+		{"server fault", errors.New("pq: relation \"users\" does not exist"), codes.Unknown, otelcodes.Error, "unknown error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, tp := newTracer()
+			_, span := tp.Tracer("test").Start(context.Background(), "op")
+			if got := RecordError(span, tt.err, errcode.FileSystemErrorCoder()); got != tt.code {
+				t.Errorf("code: got %v; want %v", got, tt.code)
+			}
+			span.End()
+
+			s := rec.Ended()[0]
+			if got := s.Status(); got.Code != tt.status || got.Description != tt.desc {
+				t.Errorf("status: got %+v; want {%v %q}", got, tt.status, tt.desc)
+			}
+			if got := len(s.Events()); got != 0 {
+				t.Errorf("events: got %d; want none", got)
+			}
+			var found bool
+			for _, kv := range s.Attributes() {
+				if kv.Key == CodeKey {
+					found = true
+					if got := codes.Code(kv.Value.AsInt64()); got != tt.code {
+						t.Errorf("attribute: got %v; want %v", got, tt.code)
+					}
+				}
+			}
+			if !found {
+				t.Errorf("missing %s attribute", CodeKey)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	rec, tp := newTracer()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "rpc")
+	interceptor := UnaryServerInterceptor()
+	handler := func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("unexpected error: %v", err)
+	}
+	span.End()
+	if got := rec.Ended()[0].Status(); got.Code != otelcodes.Error || got.Description != "denied" {
+		t.Errorf("status: got %+v", got)
+	}
+}
+
+func TestHandlerFunc(t *testing.T) {
+	rec, tp := newTracer()
+	fn := HandlerFunc(func(http.ResponseWriter, *http.Request) error {
+		return fmt.Errorf("read: %w", context.DeadlineExceeded)
+	})
+	h := httpmw.Handler(errcode.ContextErrorCoder(), fn)
+	ctx, span := tp.Tracer("test").Start(context.Background(), "http")
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+	span.End()
+	if got := rec.Ended()[0].Status(); got.Code != otelcodes.Error || got.Description != "read: context deadline exceeded" {
+		t.Errorf("status: got %+v", got)
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Code generated by gentables; DO NOT EDIT.
+
+package pgerr
+
+import "google.golang.org/grpc/codes"
+
+// SEE: https://www.postgresql.org/docs/current/errcodes-appendix.html
+
+var pgCodes = map[string]codes.Code{
+	"P0002": codes.NotFound, // no_data_found
+
+	"2201W": codes.OutOfRange, // invalid_row_count_in_limit_clause
+	"2201X": codes.OutOfRange, // invalid_row_count_in_result_offset_clause
+
+	"23502": codes.InvalidArgument,    // not_null_violation
+	"23503": codes.FailedPrecondition, // foreign_key_violation
+	"23514": codes.InvalidArgument,    // check_violation
+	"23P01": codes.FailedPrecondition, // exclusion_violation
+
+	"42501": codes.PermissionDenied, // insufficient_privilege
+	"42P01": codes.NotFound,         // undefined_table
+	"42883": codes.NotFound,         // undefined_function
+	"42704": codes.NotFound,         // undefined_object
+	"42P04": codes.AlreadyExists,    // duplicate_database
+	"42P05": codes.AlreadyExists,    // duplicate_prepared_statement
+	"42P06": codes.AlreadyExists,    // duplicate_schema
+	"42P07": codes.AlreadyExists,    // duplicate_table
+	"42710": codes.AlreadyExists,    // duplicate_object
+	"42723": codes.AlreadyExists,    // duplicate_function
+
+	"55P03": codes.Aborted, // lock_not_available
+
+	"57014": codes.Canceled, // query_canceled
+	"57P04": codes.NotFound, // database_dropped
+
+	"XX001": codes.DataLoss, // data_corrupted
+	"XX002": codes.DataLoss, // index_corrupted
+
+	"53": codes.ResourceExhausted,  // insufficient_resources
+	"54": codes.ResourceExhausted,  // program_limit_exceeded
+	"55": codes.FailedPrecondition, // object_not_in_prerequisite_state
+	"57": codes.Unavailable,        // operator_intervention
+	"58": codes.Internal,           // system_error
+	"72": codes.FailedPrecondition, // snapshot_too_old
+	"F0": codes.Internal,           // config_file_error
+	"HV": codes.Internal,           // fdw_error
+	"XX": codes.Internal,           // internal_error
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package pgerr provides the ability to extract the status code from PostgreSQL errors
+// from the github.com/jackc/pgx/v5 package.
+package pgerr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/sqlstate"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the PostgreSQL ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+//go:generate go run -C .. ./cmd/gentables -vendor=postgres -policy=pgerr/codes.txt -out=pgerr/codes_gen.go -pkg=pgerr -var=pgCodes
+
+var mapping = sqlstate.Standard().Extend(pgCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *pgconn.PgError or another pgx error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*pgconn.PgError](err); ok {
+		return SQLStateCode(e.Code)
+	}
+	switch {
+	case errcode.Is(err, pgx.ErrNoRows):
+		return codes.NotFound
+	case errcode.Is(err, pgx.ErrTxClosed), errcode.Is(err, pgx.ErrTxCommitRollback):
+		return codes.FailedPrecondition
+	case errcode.WithinLimits(err) && pgconn.Timeout(err):
+		return codes.DeadlineExceeded
+	}
+	if _, ok := errcode.As[*pgconn.ConnectError](err); ok {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// SQLStateCode returns the gRPC code associated with the given PostgreSQL SQLSTATE.
+func SQLStateCode(state string) codes.Code {
+	return mapping.Code(state)
+}
+
+// Mapping returns the PostgreSQL SQLSTATE mapping, which extends the standard mapping.
+// It may be used with other PostgreSQL drivers, such as github.com/lib/pq.
+func Mapping() *sqlstate.Mapping {
+	return mapping
+}
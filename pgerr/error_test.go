@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package pgerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "unique violation", Err: &pgconn.PgError{Code: "23505"}, Want: codes.AlreadyExists},
+		{Name: "foreign key violation", Err: &pgconn.PgError{Code: "23503"}, Want: codes.FailedPrecondition},
+		{Name: "serialization failure", Err: &pgconn.PgError{Code: "40001"}, Want: codes.Aborted},
+		{Name: "query canceled", Err: &pgconn.PgError{Code: "57014"}, Want: codes.Canceled},
+		{Name: "too many connections", Err: &pgconn.PgError{Code: "53300"}, Want: codes.ResourceExhausted},
+		{Name: "undefined table", Err: &pgconn.PgError{Code: "42P01"}, Want: codes.NotFound},
+		{Name: "syntax error", Err: &pgconn.PgError{Code: "42601"}, Want: codes.InvalidArgument},
+		{Name: "no rows", Err: pgx.ErrNoRows, Want: codes.NotFound},
+		{Name: "tx closed", Err: pgx.ErrTxClosed, Want: codes.FailedPrecondition},
+	})
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package promcoder provides an ErrorCoder that counts resolved codes
+// with a CounterVec from the github.com/prometheus/client_golang package.
+package promcoder
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// DefaultCodeLabel is the default name of the label whose value is a code.
+const DefaultCodeLabel = "code"
+
+// An Option configures an ErrorCoder.
+type Option func(*options)
+
+type options struct {
+	codeLabel string
+	labels    prometheus.Labels
+}
+
+// WithCodeLabel returns an Option that sets the name of the label whose value
+// is the name of a code, such as "NotFound". By default, it's DefaultCodeLabel.
+func WithCodeLabel(name string) Option {
+	return func(o *options) { o.codeLabel = name }
+}
+
+// WithLabels returns an Option that sets the values of the CounterVec's other
+// labels, such as an operation's name. Every label except the code label must
+// have a value.
+func WithLabels(labels prometheus.Labels) Option {
+	return func(o *options) { o.labels = labels }
+}
+
+// NewCounterVec returns a new CounterVec with the code label and the given
+// label names. It must be registered by the caller.
+func NewCounterVec(opts prometheus.CounterOpts, labelNames ...string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(opts, append([]string{DefaultCodeLabel}, labelNames...))
+}
+
+type coder struct {
+	coder     errcode.ErrorCoder
+	counter   *prometheus.CounterVec
+	codeLabel string
+	counters  [codes.Unauthenticated + 1]prometheus.Counter
+}
+
+// Wrap returns an ErrorCoder that resolves codes with the given ErrorCoder
+// and increments the counter labeled with each non-nil error's code.
+//
+// Every call increments the counter, so it should wrap the outermost ErrorCoder
+// of a composition and be called once per error, such as by middleware.
+//
+// It panics if the labels don't match the CounterVec's label names, like
+// prometheus.CounterVec.MustCurryWith.
+func Wrap(c errcode.ErrorCoder, counter *prometheus.CounterVec, opts ...Option) errcode.ErrorCoder {
+	o := &options{codeLabel: DefaultCodeLabel}
+	for _, opt := range opts {
+		opt(o)
+	}
+	x := &coder{
+		coder:     c,
+		counter:   counter.MustCurryWith(o.labels),
+		codeLabel: o.codeLabel,
+	}
+	for i := range x.counters {
+		// Every code's counter is initialized so that its
+		// rate is well defined before its first error.
+		x.counters[i] = x.counter.With(prometheus.Labels{o.codeLabel: codes.Code(i).String()})
+	}
+	return x
+}
+
+func (c *coder) ErrorCode(err error) codes.Code {
+	code := c.coder.ErrorCode(err)
+	if err == nil {
+		return code
+	}
+	if int(code) < len(c.counters) {
+		c.counters[code].Inc()
+	} else {
+		c.counter.With(prometheus.Labels{c.codeLabel: code.String()}).Inc()
+	}
+	return code
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package promcoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc/codes"
+)
+
+func TestWrap(t *testing.T) {
+	counter := NewCounterVec(prometheus.CounterOpts{
+		Name: "errors_total",
+		Help: "Errors by code.",
+	}, "operation")
+	coder := Wrap(errcode.ContextErrorCoder(), counter, WithLabels(prometheus.Labels{"operation": "get"}))
+
+	for _, err := range []error{
+		nil,
+		context.Canceled,
+		fmt.Errorf("call: %w", context.Canceled),
+		errors.New("boom"),
+	} {
+		coder.ErrorCode(err)
+	}
+
+	for _, tt := range []struct {
+		code codes.Code
+		want float64
+	}{
+		{codes.OK, 0},
+		{codes.Canceled, 2},
+		{codes.Unknown, 1},
+		{codes.NotFound, 0},
+	} {
+		c := counter.WithLabelValues(tt.code.String(), "get")
+		if got := testutil.ToFloat64(c); got != tt.want {
+			t.Errorf("%v: got %v; want %v", tt.code, got, tt.want)
+		}
+	}
+	if got, want := testutil.CollectAndCount(counter), int(codes.Unauthenticated)+1; got != want {
+		t.Errorf("series: got %d; want %d", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+// A PublicError is an error with a message that may be presented to clients,
+// which is distinct from its own message that may describe internal details.
+type PublicError interface {
+	PublicMessage() string
+	error
+}
+
+// WithPublicMessage wraps the given error and adds a message that may be
+// presented to clients. The error's own message is unchanged, so it may still
+// be logged. If the error is nil, it returns nil.
+//
+// The gRPC interceptors, HTTP handlers, and status conversions of this module
+// send the public message instead of the error's message.
+func WithPublicMessage(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return &publicError{err, msg}
+}
+
+type publicError struct {
+	err error
+	msg string
+}
+
+func (pe *publicError) PublicMessage() string { return pe.msg }
+func (pe *publicError) Error() string         { return pe.err.Error() }
+func (pe *publicError) Unwrap() error         { return pe.err }
+
+// PublicMessage returns the message of the first PublicError in err's tree,
+// in a pre-order traversal, and reports whether there is one.
+func PublicMessage(err error) (string, bool) {
+	if e, ok := As[PublicError](err); ok {
+		return e.PublicMessage(), true
+	}
+	return "", false
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestPublicMessage(t *testing.T) {
+	if err := WithPublicMessage(nil, "public"); err != nil {
+		t.Errorf("WithPublicMessage(nil): got %v; want nil", err)
+	}
+	if msg, ok := PublicMessage(errors.New("internal")); ok {
+		t.Errorf("PublicMessage: got %q; want none", msg)
+	}
+
+	inner := New(codes.NotFound, errors.New("select * from users: no rows"))
+	err := fmt.Errorf("get user: %w", WithPublicMessage(inner, "user not found"))
+	if msg, ok := PublicMessage(err); !ok || msg != "user not found" {
+		t.Errorf("PublicMessage: got (%q, %v); want (%q, true)", msg, ok, "user not found")
+	}
+	if want := "get user: select * from users: no rows"; err.Error() != want {
+		t.Errorf("Error: got %q; want %q", err, want)
+	}
+	if got := CodedErrorCoder().ErrorCode(err); got != codes.NotFound {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.NotFound)
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package pubsuberr provides the ability to extract the status code from Pub/Sub errors
+// from the cloud.google.com/go/pubsub package.
+//
+// The Pub/Sub client reports publishing failures with its own errors,
+// so it should be used before the grpcerr and googleapierr ErrorCoders.
+package pubsuberr
+
+import (
+	"bursavich.dev/errcode"
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Pub/Sub ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	pubsub.ErrOversizedMessage:                     codes.InvalidArgument,
+	pubsub.ErrEmptyProjectID:                       codes.InvalidArgument,
+	pubsub.ErrFlowControllerMaxOutstandingMessages: codes.ResourceExhausted,
+	pubsub.ErrFlowControllerMaxOutstandingBytes:    codes.ResourceExhausted,
+	pubsub.ErrTopicStopped:                         codes.FailedPrecondition,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a known Pub/Sub publishing error.
+//
+// Publishing for an ordering key is paused after a failure until it's resumed,
+// so pubsub.ErrPublishingPaused is mapped to FailedPrecondition.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if code := sentinelCoder.ErrorCode(err); code != codes.Unknown {
+		return code
+	}
+	if _, ok := PausedOrderingKey(err); ok {
+		return codes.FailedPrecondition
+	}
+	return codes.Unknown
+}
+
+// PausedOrderingKey returns the ordering key for which publishing is paused
+// if the error contains a pubsub.ErrPublishingPaused. Publishing may be
+// resumed by calling the topic's ResumePublish method with the key.
+func PausedOrderingKey(err error) (string, bool) {
+	if e, ok := errcode.As[pubsub.ErrPublishingPaused](err); ok {
+		return e.OrderingKey, true
+	}
+	return "", false
+}
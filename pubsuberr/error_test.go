@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package pubsuberr
+
+import (
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "oversized message", Err: pubsub.ErrOversizedMessage, Want: codes.InvalidArgument},
+		{Name: "max outstanding messages", Err: pubsub.ErrFlowControllerMaxOutstandingMessages, Want: codes.ResourceExhausted},
+		{Name: "max outstanding bytes", Err: pubsub.ErrFlowControllerMaxOutstandingBytes, Want: codes.ResourceExhausted},
+		{Name: "topic stopped", Err: pubsub.ErrTopicStopped, Want: codes.FailedPrecondition},
+		{Name: "publishing paused", Err: pubsub.ErrPublishingPaused{OrderingKey: "user-1"}, Want: codes.FailedPrecondition},
+	})
+}
+
+func TestPausedOrderingKey(t *testing.T) {
+	err := fmt.Errorf("publish: %w", pubsub.ErrPublishingPaused{OrderingKey: "user-1"})
+	if key, ok := PausedOrderingKey(err); !ok || key != "user-1" {
+		t.Errorf("PausedOrderingKey(%v): got %q, %v; want %q, true", err, key, ok, "user-1")
+	}
+	if key, ok := PausedOrderingKey(pubsub.ErrTopicStopped); ok {
+		t.Errorf("PausedOrderingKey(%v): got %q, %v; want false", pubsub.ErrTopicStopped, key, ok)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package pulsarerr provides the ability to extract the status code from Pulsar errors
+// from the github.com/apache/pulsar-client-go/pulsar package.
+package pulsarerr
+
+import (
+	"bursavich.dev/errcode"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Pulsar ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://github.com/apache/pulsar-client-go/blob/master/pulsar/error.go
+
+// A *pulsar.Error is never OK, so pulsar.Ok isn't mapped.
+var resultCodes = map[pulsar.Result]codes.Code{
+	pulsar.InvalidConfiguration:    codes.InvalidArgument, // invalid configuration
+	pulsar.InvalidMessage:          codes.InvalidArgument, // error in publishing an already used message
+	pulsar.InvalidTopicName:        codes.InvalidArgument, // invalid topic name
+	pulsar.InvalidURL:              codes.InvalidArgument, // client initialized with invalid broker url
+	pulsar.MessageTooBig:           codes.InvalidArgument, // trying to send a message exceeding the max size
+	pulsar.InvalidBatchBuilderType: codes.InvalidArgument, // invalid batch builder type
+
+	pulsar.TimeoutError: codes.DeadlineExceeded, // operation timed out
+
+	pulsar.TopicNotFound:        codes.NotFound, // topic not found
+	pulsar.SubscriptionNotFound: codes.NotFound, // subscription not found
+	pulsar.ConsumerNotFound:     codes.NotFound, // consumer not found
+
+	pulsar.AuthorizationError: codes.PermissionDenied, // client is not authorized to create producer/consumer
+
+	pulsar.TooManyLookupRequestException:         codes.ResourceExhausted, // too many concurrent lookup requests
+	pulsar.ProducerBlockedQuotaExceededError:     codes.ResourceExhausted, // producer is blocked
+	pulsar.ProducerBlockedQuotaExceededException: codes.ResourceExhausted, // producer is getting exception
+	pulsar.ProducerQueueIsFull:                   codes.ResourceExhausted, // producer queue is full
+
+	pulsar.ConsumerBusy:           codes.FailedPrecondition, // exclusive consumer is already connected
+	pulsar.AlreadyClosedError:     codes.FailedPrecondition, // producer/consumer is already closed
+	pulsar.ConsumerNotInitialized: codes.FailedPrecondition, // consumer is not initialized
+	pulsar.ProducerNotInitialized: codes.FailedPrecondition, // producer is not initialized
+	pulsar.TopicTerminated:        codes.FailedPrecondition, // topic was already terminated
+	pulsar.ConsumerClosed:         codes.FailedPrecondition, // consumer has already been closed
+	pulsar.ProducerClosed:         codes.FailedPrecondition, // producer has already been closed
+
+	pulsar.OperationNotSupported:   codes.Unimplemented, // operation not supported
+	pulsar.UnsupportedVersionError: codes.Unimplemented, // an older client/version doesn't support a required feature
+
+	pulsar.BrokerMetadataError:    codes.Internal, // broker failed in updating metadata
+	pulsar.BrokerPersistenceError: codes.Internal, // broker failed to persist entry
+	pulsar.CryptoError:            codes.Internal, // crypto operation failed
+	pulsar.AddToBatchFailed:       codes.Internal, // failed to add send request to batch builder
+	pulsar.SeekFailed:             codes.Internal, // seek failed
+
+	pulsar.LookupError:         codes.Unavailable, // broker lookup failed
+	pulsar.ConnectError:        codes.Unavailable, // failed to connect to broker
+	pulsar.NotConnectedError:   codes.Unavailable, // producer/consumer is not currently connected to broker
+	pulsar.ServiceUnitNotReady: codes.Unavailable, // topic was unloaded between lookup and producer/consumer creation
+
+	pulsar.ChecksumError: codes.DataLoss, // corrupt message checksum failure
+
+	pulsar.AuthenticationError:            codes.Unauthenticated, // authentication failed on broker
+	pulsar.ErrorGettingAuthenticationData: codes.Unauthenticated, // client cannot find authentication data
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *pulsar.Error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*pulsar.Error](err); ok {
+		return ResultCode(e.Result())
+	}
+	return codes.Unknown
+}
+
+// ResultCode returns the gRPC code associated with the given Pulsar result.
+// It returns codes.Unknown if the result isn't recognized.
+func ResultCode(result pulsar.Result) codes.Code {
+	if code, ok := resultCodes[result]; ok {
+		return code
+	}
+	return codes.Unknown
+}
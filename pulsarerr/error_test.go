@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package pulsarerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "ok", Err: &pulsar.Error{}, Want: codes.Unknown},
+	})
+}
+
+func TestResultCode(t *testing.T) {
+	tests := []struct {
+		result pulsar.Result
+		want   codes.Code
+	}{
+		{pulsar.Ok, codes.Unknown},
+		{pulsar.InvalidTopicName, codes.InvalidArgument},
+		{pulsar.TimeoutError, codes.DeadlineExceeded},
+		{pulsar.TopicNotFound, codes.NotFound},
+		{pulsar.AuthorizationError, codes.PermissionDenied},
+		{pulsar.ProducerQueueIsFull, codes.ResourceExhausted},
+		{pulsar.ProducerClosed, codes.FailedPrecondition},
+		{pulsar.OperationNotSupported, codes.Unimplemented},
+		{pulsar.CryptoError, codes.Internal},
+		{pulsar.ConnectError, codes.Unavailable},
+		{pulsar.ChecksumError, codes.DataLoss},
+		{pulsar.AuthenticationError, codes.Unauthenticated},
+		{pulsar.Result(-1), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ResultCode(tt.result); got != tt.want {
+			t.Errorf("ResultCode(%v): got %v; want %v", tt.result, got, tt.want)
+		}
+	}
+}
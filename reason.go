@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+// A ReasonError is an error with a machine-readable reason, which identifies
+// the cause of the error more precisely than its code. It's modeled on the
+// google.rpc.ErrorInfo message.
+//
+// The reason is a stable identifier, such as "API_DISABLED", which must be
+// unique within its domain. The domain is the logical grouping to which the
+// reason belongs, which is typically the name of the service that generates
+// it, such as "pubsub.googleapis.com".
+type ReasonError interface {
+	Reason() (domain, reason string)
+	error
+}
+
+// WithReason wraps the given error and adds a machine-readable reason in the
+// given domain, so clients may branch on it rather than on the error's message.
+// If the error is nil, it returns nil.
+//
+// The gRPC interceptors, HTTP handlers, and status conversions of this module
+// send the reason in ErrorInfo details and response bodies.
+func WithReason(err error, domain, reason string) error {
+	if err == nil {
+		return nil
+	}
+	return &reasonError{err, domain, reason}
+}
+
+type reasonError struct {
+	err    error
+	domain string
+	reason string
+}
+
+func (re *reasonError) Reason() (domain, reason string) { return re.domain, re.reason }
+func (re *reasonError) Error() string                   { return re.err.Error() }
+func (re *reasonError) Unwrap() error                   { return re.err }
+
+// Reason returns the domain and reason of the first ReasonError in err's tree,
+// in a pre-order traversal, and reports whether there is one.
+func Reason(err error) (domain, reason string, ok bool) {
+	if e, ok := As[ReasonError](err); ok {
+		domain, reason = e.Reason()
+		return domain, reason, true
+	}
+	return "", "", false
+}
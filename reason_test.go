@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestReason(t *testing.T) {
+	if err := WithReason(nil, "example.com", "USER_NOT_FOUND"); err != nil {
+		t.Errorf("WithReason(nil): got %v; want nil", err)
+	}
+	if domain, reason, ok := Reason(errors.New("internal")); ok {
+		t.Errorf("Reason: got (%q, %q); want none", domain, reason)
+	}
+
+	inner := New(codes.NotFound, errors.New("no rows"))
+	err := errors.Join(
+		errors.New("other"),
+		fmt.Errorf("get user: %w", WithReason(inner, "example.com", "USER_NOT_FOUND")),
+		WithReason(errors.New("later"), "example.com", "IGNORED"),
+	)
+	if domain, reason, ok := Reason(err); !ok || domain != "example.com" || reason != "USER_NOT_FOUND" {
+		t.Errorf("Reason: got (%q, %q, %v); want (%q, %q, true)", domain, reason, ok, "example.com", "USER_NOT_FOUND")
+	}
+	if got := CodedErrorCoder().ErrorCode(err); got != codes.NotFound {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.NotFound)
+	}
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package rediserr provides the ability to extract the status code from Redis errors
+// from the github.com/redis/go-redis/v9 package.
+package rediserr
+
+import (
+	"strings"
+
+	"bursavich.dev/errcode"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Redis ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://redis.io/docs/latest/develop/reference/protocol-spec/#simple-errors
+
+var prefixCodes = map[string]codes.Code{
+	"NOSCRIPT": codes.NotFound, // No matching script. Please use EVAL.
+	"NOGROUP":  codes.NotFound, // No such key or consumer group.
+
+	"BUSYGROUP": codes.AlreadyExists, // Consumer Group name already exists.
+
+	"NOPERM": codes.PermissionDenied, // User has no permissions to run the command.
+
+	"OOM": codes.ResourceExhausted, // Command not allowed when used memory > 'maxmemory'.
+
+	"WRONGTYPE": codes.FailedPrecondition, // Operation against a key holding the wrong kind of value.
+
+	"EXECABORT": codes.Aborted, // Transaction discarded because of previous errors.
+
+	"BUSY":        codes.Unavailable, // Redis is busy running a script.
+	"CLUSTERDOWN": codes.Unavailable, // The cluster is down.
+	"LOADING":     codes.Unavailable, // Redis is loading the dataset in memory.
+	"MASTERDOWN":  codes.Unavailable, // Link with MASTER is down and replica-serve-stale-data is set to 'no'.
+	"READONLY":    codes.Unavailable, // You can't write against a read only replica.
+	"TRYAGAIN":    codes.Unavailable, // Multiple keys request during rehashing of slot.
+
+	"NOAUTH":    codes.Unauthenticated, // Authentication required.
+	"WRONGPASS": codes.Unauthenticated, // Invalid username-password pair or user is disabled.
+}
+
+var errorCodes = map[error]codes.Code{
+	redis.Nil:              codes.NotFound,
+	redis.TxFailedErr:      codes.Aborted,
+	redis.ErrPoolTimeout:   codes.DeadlineExceeded,
+	redis.ErrPoolExhausted: codes.ResourceExhausted,
+	redis.ErrClosed:        codes.FailedPrecondition,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a redis.Error or a known go-redis sentinel error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if code := sentinelCoder.ErrorCode(err); code != codes.Unknown {
+		return code
+	}
+	if e, ok := errcode.As[redis.Error](err); ok {
+		return ReplyCode(e.Error())
+	}
+	return codes.Unknown
+}
+
+// ReplyCode returns the gRPC code associated with the given Redis error reply.
+func ReplyCode(msg string) codes.Code {
+	if msg == "ERR max number of clients reached" {
+		return codes.ResourceExhausted
+	}
+	msg = strings.TrimPrefix(msg, "ERR ") // KVRocks adds such prefix
+	prefix, _, _ := strings.Cut(msg, " ")
+	if code, ok := prefixCodes[prefix]; ok {
+		return code
+	}
+	return codes.Unknown
+}
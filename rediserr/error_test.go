@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package rediserr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+)
+
+// replyError is a redis.Error, like the replies parsed by the client.
+type replyError string
+
+func (e replyError) Error() string { return string(e) }
+func (replyError) RedisError()     {}
+
+var _ redis.Error = replyError("")
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "nil reply", Err: redis.Nil, Want: codes.NotFound},
+		{Name: "pool timeout", Err: redis.ErrPoolTimeout, Want: codes.DeadlineExceeded},
+		{Name: "tx failed", Err: redis.TxFailedErr, Want: codes.Aborted},
+		{Name: "loading", Err: replyError("LOADING Redis is loading the dataset in memory"), Want: codes.Unavailable},
+		{Name: "clusterdown", Err: replyError("CLUSTERDOWN The cluster is down"), Want: codes.Unavailable},
+		{Name: "readonly", Err: replyError("READONLY You can't write against a read only replica."), Want: codes.Unavailable},
+		{Name: "noauth", Err: replyError("NOAUTH Authentication required."), Want: codes.Unauthenticated},
+		{Name: "wrongpass", Err: replyError("WRONGPASS invalid username-password pair"), Want: codes.Unauthenticated},
+		{Name: "noperm", Err: replyError("NOPERM this user has no permissions to run the 'get' command"), Want: codes.PermissionDenied},
+		{Name: "oom", Err: replyError("OOM command not allowed when used memory > 'maxmemory'."), Want: codes.ResourceExhausted},
+		{Name: "kvrocks", Err: replyError("ERR NOPERM no permission"), Want: codes.PermissionDenied},
+		{Name: "max clients", Err: replyError("ERR max number of clients reached"), Want: codes.ResourceExhausted},
+		{Name: "unmapped", Err: replyError("ERR unknown command 'FOO'"), Want: codes.Unknown},
+	})
+}
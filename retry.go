@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// A RetryDelayError is an error that reports how long to wait before retrying
+// the operation that failed, such as an HTTP response with a Retry-After header.
+type RetryDelayError interface {
+	// RetryDelay returns the delay and reports whether it's known.
+	RetryDelay() (time.Duration, bool)
+	error
+}
+
+// RetryDelay returns the first known delay reported by a RetryDelayError
+// in err's tree, in a pre-order traversal. Negative delays are reported as zero.
+//
+// It allows retry loops to honor server-provided backoff hints
+// regardless of the protocol from which they originate.
+func RetryDelay(err error) (time.Duration, bool) {
+	var (
+		delay time.Duration
+		found bool
+	)
+	Walk(err, func(err error) bool {
+		if e, ok := err.(RetryDelayError); ok {
+			delay, found = e.RetryDelay()
+		}
+		return !found
+	})
+	return max(delay, 0), found
+}
+
+// RetryableCode reports whether an operation that failed with the code may be
+// retried: Unavailable errors are transient, ResourceExhausted errors may
+// succeed after a backoff, and Aborted errors may succeed if the operation
+// is retried at a higher level, such as a transaction.
+func RetryableCode(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// IsThrottled reports whether the error's code resolved by the coders is
+// ResourceExhausted, which is the code to which throttling errors from every
+// source are mapped, such as HTTP 429 responses, AWS Throttling errors,
+// Google API rateLimitExceeded errors, and Kafka THROTTLING_QUOTA_EXCEEDED errors.
+//
+// Any wait duration provided by the server may be found with ThrottleDelay.
+func IsThrottled(err error, coders ...ErrorCoder) bool {
+	return err != nil && Compact(coders...).ErrorCode(err) == codes.ResourceExhausted
+}
+
+// ThrottleDelay returns the delay provided by the server if the error
+// is throttled, as reported by IsThrottled, and reports whether it's known.
+func ThrottleDelay(err error, coders ...ErrorCoder) (time.Duration, bool) {
+	if !IsThrottled(err, coders...) {
+		return 0, false
+	}
+	return RetryDelay(err)
+}
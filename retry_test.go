@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+type retryDelayError struct {
+	delay time.Duration
+	ok    bool
+}
+
+func (e *retryDelayError) Error() string                     { return "retry" }
+func (e *retryDelayError) RetryDelay() (time.Duration, bool) { return e.delay, e.ok }
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		delay time.Duration
+		ok    bool
+	}{
+		{name: "nil"},
+		{name: "plain", err: errors.New("plain")},
+		{
+			name:  "wrapped",
+			err:   fmt.Errorf("call: %w", &retryDelayError{delay: time.Second, ok: true}),
+			delay: time.Second,
+			ok:    true,
+		},
+		{
+			name: "first known",
+			err: errors.Join(
+				&retryDelayError{delay: time.Minute},
+				&retryDelayError{delay: 2 * time.Second, ok: true},
+				&retryDelayError{delay: 3 * time.Second, ok: true},
+			),
+			delay: 2 * time.Second,
+			ok:    true,
+		},
+		{
+			name: "negative",
+			err:  &retryDelayError{delay: -time.Second, ok: true},
+			ok:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := RetryDelay(tt.err)
+			if delay != tt.delay || ok != tt.ok {
+				t.Errorf("RetryDelay: got %v, %v; want %v, %v", delay, ok, tt.delay, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRetryableCode(t *testing.T) {
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		want := code == codes.Unavailable || code == codes.ResourceExhausted || code == codes.Aborted
+		if got := RetryableCode(code); got != want {
+			t.Errorf("RetryableCode(%v): got %v; want %v", code, got, want)
+		}
+	}
+}
+
+func TestIsThrottled(t *testing.T) {
+	coder := CodedErrorCoder()
+	tests := []struct {
+		name      string
+		err       error
+		throttled bool
+		delay     time.Duration
+		ok        bool
+	}{
+		{name: "nil"},
+		{name: "unavailable", err: New(codes.Unavailable, &retryDelayError{delay: time.Second, ok: true})},
+		{name: "no delay", err: New(codes.ResourceExhausted, errors.New("slow down")), throttled: true},
+		{
+			name:      "delay",
+			err:       New(codes.ResourceExhausted, &retryDelayError{delay: time.Second, ok: true}),
+			throttled: true,
+			delay:     time.Second,
+			ok:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsThrottled(tt.err, coder); got != tt.throttled {
+				t.Errorf("IsThrottled: got %v; want %v", got, tt.throttled)
+			}
+			delay, ok := ThrottleDelay(tt.err, coder)
+			if delay != tt.delay || ok != tt.ok {
+				t.Errorf("ThrottleDelay: got (%v, %v); want (%v, %v)", delay, ok, tt.delay, tt.ok)
+			}
+		})
+	}
+}
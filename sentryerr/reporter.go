@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package sentryerr provides an errreport.Reporter that sends errors to Sentry
+// with the github.com/getsentry/sentry-go package.
+package sentryerr
+
+import (
+	"context"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errreport"
+	"github.com/getsentry/sentry-go"
+)
+
+// CodeTag is the tag whose value is the canonical name of an error's code.
+const CodeTag = "grpc.code"
+
+var levels = map[errreport.Severity]sentry.Level{
+	errreport.Info:    sentry.LevelInfo,
+	errreport.Warning: sentry.LevelWarning,
+	errreport.Error:   sentry.LevelError,
+	errreport.Fatal:   sentry.LevelFatal,
+}
+
+// Reporter returns an errreport.Reporter that captures events with the Hub
+// from the context, as installed by Sentry's middleware, or the given Hub
+// if there isn't one. If the given Hub is nil, sentry.CurrentHub is used.
+//
+// Each event's severity is its level, its code is the CodeTag tag, and its
+// fingerprint extends Sentry's default grouping, so that errors with the
+// same stack trace but different codes are grouped separately.
+func Reporter(hub *sentry.Hub) errreport.Reporter {
+	return errreport.ReporterFunc(func(ctx context.Context, event *errreport.Event) {
+		h := sentry.GetHubFromContext(ctx)
+		if h == nil {
+			h = hub
+		}
+		if h == nil {
+			h = sentry.CurrentHub()
+		}
+		h.WithScope(func(scope *sentry.Scope) {
+			if level, ok := levels[event.Severity]; ok {
+				scope.SetLevel(level)
+			}
+			scope.SetTag(CodeTag, errcode.CodeString(event.Code))
+			if len(event.Fingerprint) > 0 {
+				scope.SetFingerprint(append([]string{"{{ default }}"}, event.Fingerprint...))
+			}
+			h.CaptureException(event.Err)
+		})
+	})
+}
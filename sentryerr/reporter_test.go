@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package sentryerr
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errreport"
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+)
+
+type transport struct {
+	sentry.Transport
+	events []*sentry.Event
+}
+
+func (t *transport) Configure(sentry.ClientOptions) {}
+func (t *transport) SendEvent(event *sentry.Event)  { t.events = append(t.events, event) }
+func (t *transport) Flush(time.Duration) bool       { return true }
+
+func TestReporter(t *testing.T) {
+	tr := &transport{}
+	client, err := sentry.NewClient(sentry.ClientOptions{Transport: tr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hub := sentry.NewHub(client, sentry.NewScope())
+	hook := errreport.NewHook(Reporter(hub), errcode.CodedErrorCoder())
+
+	hook.Report(context.Background(), errcode.New(codes.NotFound, errors.New("missing")))
+	hook.Report(context.Background(), errcode.New(codes.Internal, errors.New("boom")))
+
+	if len(tr.events) != 1 {
+		t.Fatalf("events: got %d; want 1", len(tr.events))
+	}
+	e := tr.events[0]
+	if e.Level != sentry.LevelError {
+		t.Errorf("level: got %v; want %v", e.Level, sentry.LevelError)
+	}
+	if got := e.Tags[CodeTag]; got != "INTERNAL" {
+		t.Errorf("tag: got %q; want %q", got, "INTERNAL")
+	}
+	if want := []string{"{{ default }}", "INTERNAL"}; !slices.Equal(e.Fingerprint, want) {
+		t.Errorf("fingerprint: got %v; want %v", e.Fingerprint, want)
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package slogerr provides the ability to log the codes of errors
+// with the log/slog package.
+//
+// The errors returned by errcode.New and errcode.NewDual implement
+// slog.LogValuer, so they're logged as a group of the same attributes
+// as Attrs, with their explicit codes.
+package slogerr
+
+import (
+	"log/slog"
+
+	"bursavich.dev/errcode"
+)
+
+// Keys of the attributes returned by Attrs.
+// They're the same as those logged by the errors of errcode.New.
+const (
+	CodeKey      = "code"
+	RetryableKey = "retryable"
+	MessageKey   = "message"
+	MetadataKey  = "metadata"
+)
+
+// Attrs returns attributes that describe the error: its code resolved by the
+// coders, as by errcode.ResolveError, whether it's retryable, its message, and its metadata, if it has any.
+// The code's value is its canonical name, such as "NOT_FOUND". An error is
+// retryable if its code is retryable, as reported by errcode.RetryableCode,
+// or if it reports a retry delay, as reported by errcode.RetryDelay.
+// The metadata is a group of the error's key-value pairs, as reported by
+// errcode.Metadata, sorted by key.
+//
+// If the error is nil, it returns nil.
+func Attrs(err error, coders ...errcode.ErrorCoder) []slog.Attr {
+	if err == nil {
+		return nil
+	}
+	code := errcode.ResolveError(errcode.Compact(coders...), err)
+	// The errors of errcode.New log the same attributes with their codes.
+	return errcode.New(code, err).(slog.LogValuer).LogValue().Group()
+}
+
+// Error returns an attribute with the given key whose value is a group of the
+// error's Attrs. If the error is nil, the group is empty, so the attribute is
+// omitted by handlers.
+func Error(key string, err error, coders ...errcode.ErrorCoder) slog.Attr {
+	return slog.Attr{Key: key, Value: slog.GroupValue(Attrs(err, coders...)...)}
+}
+
+// Valuer returns a slog.LogValuer whose value is a group of the error's Attrs.
+// It defers resolving the code until a record is handled, so it's cheap to
+// pass to a disabled logger.
+func Valuer(err error, coders ...errcode.ErrorCoder) slog.LogValuer {
+	return &valuer{err: err, coders: coders}
+}
+
+type valuer struct {
+	err    error
+	coders []errcode.ErrorCoder
+}
+
+func (v *valuer) LogValue() slog.Value {
+	return slog.GroupValue(Attrs(v.err, v.coders...)...)
+}
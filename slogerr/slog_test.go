@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package slogerr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAttrs(t *testing.T) {
+	if got := Attrs(nil); got != nil {
+		t.Errorf("nil: got %v; want nil", got)
+	}
+	err := errcode.New(codes.Unavailable, errors.New("try again"))
+	want := []slog.Attr{
+		slog.String(CodeKey, "UNAVAILABLE"),
+		slog.Bool(RetryableKey, true),
+		slog.String(MessageKey, "try again"),
+	}
+	got := Attrs(err, errcode.CodedErrorCoder())
+	if len(got) != len(want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("attr %d: got %v; want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	err := fmt.Errorf("query: %w", context.Canceled)
+	logger.Error("failed", Error("err", err, errcode.ContextErrorCoder()))
+	logger.Error("failed", "err", Valuer(err, errcode.ContextErrorCoder()))
+	line := `level=ERROR msg=failed err.code=CANCELLED err.retryable=false err.message="query: context canceled"` + "\n"
+	if got, want := b.String(), line+line; got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	var b bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&b, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	err := errcode.WithRequestID(errcode.WithMetadata(errors.New("boom"), "user", "42"), "req-1")
+	logger.Error("failed", Error("err", err))
+	want := `{"level":"ERROR","msg":"failed","err":{"code":"UNKNOWN","retryable":false,"message":"boom","metadata":{"request_id":"req-1","user":"42"}}}` + "\n"
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestResolveError(t *testing.T) {
+	ok := errcode.FromFunc(func(error) codes.Code { return codes.OK })
+	got := Attrs(errors.New("boom"), ok)
+	if want := slog.String(CodeKey, "UNKNOWN"); !got[0].Equal(want) {
+		t.Errorf("got %v; want %v", got[0], want)
+	}
+}
+
+func TestLogValuer(t *testing.T) {
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Error("failed", "err", errcode.New(codes.NotFound, errors.New("missing")))
+	logger.Error("failed", "err", errcode.NewDual(codes.Aborted, http.StatusConflict, errors.New("conflict")))
+	want := `level=ERROR msg=failed err.code=NOT_FOUND err.retryable=false err.message=missing` + "\n" +
+		`level=ERROR msg=failed err.code=ABORTED err.retryable=true err.message=conflict` + "\n"
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
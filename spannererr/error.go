@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package spannererr provides the ability to extract the status code from Cloud Spanner errors
+// from the cloud.google.com/go/spanner package.
+package spannererr
+
+import (
+	"strings"
+
+	"bursavich.dev/errcode"
+	"cloud.google.com/go/spanner"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Cloud Spanner ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+const sessionResourceType = "type.googleapis.com/google.spanner.v1.Session"
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a *spanner.Error.
+//
+// Aborted transactions are reported as Aborted. A missing session
+// isn't a missing resource of the caller, so it's reported as Unavailable
+// rather than NotFound.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*spanner.Error](err); ok {
+		if IsSessionNotFound(e) {
+			return codes.Unavailable
+		}
+		return spanner.ErrCode(e)
+	}
+	return codes.Unknown
+}
+
+// IsAborted reports whether the error is an aborted transaction,
+// which should be retried.
+func IsAborted(err error) bool {
+	if e, ok := errcode.As[*spanner.Error](err); ok {
+		return spanner.ErrCode(e) == codes.Aborted
+	}
+	return false
+}
+
+// IsSessionNotFound reports whether the error is caused by a session
+// that was deleted or expired.
+func IsSessionNotFound(err error) bool {
+	e, ok := errcode.As[*spanner.Error](err)
+	if !ok || spanner.ErrCode(e) != codes.NotFound {
+		return false
+	}
+	s, ok := status.FromError(e)
+	if !ok {
+		return false
+	}
+	for _, d := range s.Details() {
+		if ri, ok := d.(*errdetails.ResourceInfo); ok && ri.ResourceType == sessionResourceType {
+			return true
+		}
+	}
+	return strings.Contains(s.Message(), "Session not found")
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package spannererr
+
+import (
+	"errors"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"cloud.google.com/go/spanner"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func spannerError(s *status.Status) error {
+	return spanner.ToSpannerError(s.Err())
+}
+
+func sessionNotFound(t *testing.T) error {
+	t.Helper()
+	s, err := status.New(codes.NotFound, "Session not found: projects/p/instances/i/databases/d/sessions/s").
+		WithDetails(&errdetails.ResourceInfo{ResourceType: sessionResourceType, ResourceName: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return spannerError(s)
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "aborted", Err: spannerError(status.New(codes.Aborted, "Transaction was aborted.")), Want: codes.Aborted},
+		{Name: "not found", Err: spannerError(status.New(codes.NotFound, "Table not found: Users")), Want: codes.NotFound},
+		{Name: "session not found", Err: sessionNotFound(t), Want: codes.Unavailable},
+		{Name: "already exists", Err: spannerError(status.New(codes.AlreadyExists, "Row [1] in table Users already exists")), Want: codes.AlreadyExists},
+	})
+}
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		err             error
+		aborted         bool
+		sessionNotFound bool
+	}{
+		{nil, false, false},
+		{spannerError(status.New(codes.Aborted, "Transaction was aborted.")), true, false},
+		{sessionNotFound(t), false, true},
+		{spannerError(status.New(codes.NotFound, "Session not found: s")), false, true},
+		{spannerError(status.New(codes.NotFound, "Table not found: Users")), false, false},
+		{errors.New("boom"), false, false},
+	}
+	for _, tt := range tests {
+		if got := IsAborted(tt.err); got != tt.aborted {
+			t.Errorf("IsAborted(%v): got %v; want %v", tt.err, got, tt.aborted)
+		}
+		if got := IsSessionNotFound(tt.err); got != tt.sessionNotFound {
+			t.Errorf("IsSessionNotFound(%v): got %v; want %v", tt.err, got, tt.sessionNotFound)
+		}
+	}
+}
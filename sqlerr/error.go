@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package sqlerr provides the ability to extract the status code from
+// driver-agnostic errors from the database/sql package.
+package sqlerr
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the database/sql ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a database/sql sentinel error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	switch {
+	case errcode.Is(err, sql.ErrNoRows):
+		return codes.NotFound
+	case errcode.Is(err, sql.ErrTxDone):
+		return codes.FailedPrecondition
+	case errcode.Is(err, sql.ErrConnDone), errcode.Is(err, driver.ErrBadConn):
+		return codes.Unavailable
+	case errcode.Is(err, driver.ErrSkip), errcode.Is(err, driver.ErrRemoveArgument):
+		// These are signals between database/sql and drivers
+		// that should never escape to callers.
+		return codes.Internal
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package sqlerr
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "no rows", Err: sql.ErrNoRows, Want: codes.NotFound},
+		{Name: "tx done", Err: sql.ErrTxDone, Want: codes.FailedPrecondition},
+		{Name: "conn done", Err: sql.ErrConnDone, Want: codes.Unavailable},
+		{Name: "bad conn", Err: driver.ErrBadConn, Want: codes.Unavailable},
+		{Name: "skip", Err: driver.ErrSkip, Want: codes.Internal},
+	})
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package sqliteerr provides the ability to extract the status code from SQLite errors
+// from the github.com/mattn/go-sqlite3 and modernc.org/sqlite packages.
+//
+// The errors are identified by their types' package paths, so that neither
+// package is imported. In particular, programs that use the pure Go
+// modernc.org/sqlite package don't require cgo.
+package sqliteerr
+
+import (
+	"reflect"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the SQLite ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://www.sqlite.org/rescode.html
+
+var primaryCodes = map[int]codes.Code{
+	2:  codes.Internal,           // SQLITE_INTERNAL
+	3:  codes.PermissionDenied,   // SQLITE_PERM
+	4:  codes.Aborted,            // SQLITE_ABORT
+	5:  codes.Aborted,            // SQLITE_BUSY
+	6:  codes.Aborted,            // SQLITE_LOCKED
+	7:  codes.ResourceExhausted,  // SQLITE_NOMEM
+	8:  codes.PermissionDenied,   // SQLITE_READONLY
+	9:  codes.Canceled,           // SQLITE_INTERRUPT
+	10: codes.Internal,           // SQLITE_IOERR
+	11: codes.DataLoss,           // SQLITE_CORRUPT
+	12: codes.NotFound,           // SQLITE_NOTFOUND
+	13: codes.ResourceExhausted,  // SQLITE_FULL
+	14: codes.Unavailable,        // SQLITE_CANTOPEN
+	15: codes.Unavailable,        // SQLITE_PROTOCOL
+	17: codes.Aborted,            // SQLITE_SCHEMA
+	18: codes.InvalidArgument,    // SQLITE_TOOBIG
+	19: codes.FailedPrecondition, // SQLITE_CONSTRAINT
+	20: codes.InvalidArgument,    // SQLITE_MISMATCH
+	21: codes.Internal,           // SQLITE_MISUSE
+	22: codes.Unimplemented,      // SQLITE_NOLFS
+	23: codes.PermissionDenied,   // SQLITE_AUTH
+	25: codes.OutOfRange,         // SQLITE_RANGE
+	26: codes.FailedPrecondition, // SQLITE_NOTADB
+}
+
+var extendedCodes = map[int]codes.Code{
+	275:  codes.InvalidArgument,    // SQLITE_CONSTRAINT_CHECK
+	787:  codes.FailedPrecondition, // SQLITE_CONSTRAINT_FOREIGNKEY
+	1299: codes.InvalidArgument,    // SQLITE_CONSTRAINT_NOTNULL
+	1555: codes.AlreadyExists,      // SQLITE_CONSTRAINT_PRIMARYKEY
+	2067: codes.AlreadyExists,      // SQLITE_CONSTRAINT_UNIQUE
+	2579: codes.AlreadyExists,      // SQLITE_CONSTRAINT_ROWID
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a sqlite3.Error from github.com/mattn/go-sqlite3
+// or an *sqlite.Error from modernc.org/sqlite.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if code, ok := findResultCode(err); ok {
+		return ResultCode(code)
+	}
+	return codes.Unknown
+}
+
+// ResultCode returns the gRPC code associated with the given SQLite result code,
+// which may be either a primary or an extended result code.
+func ResultCode(code int) codes.Code {
+	if c, ok := extendedCodes[code]; ok {
+		return c
+	}
+	if c, ok := primaryCodes[code&0xff]; ok {
+		return c
+	}
+	return codes.Unknown
+}
+
+// moderncError is implemented by *sqlite.Error from modernc.org/sqlite,
+// whose Code method returns an extended result code.
+type moderncError interface {
+	Code() int
+	error
+}
+
+// findResultCode returns the extended result code of the first SQLite error
+// in err's tree. The errors are identified by their package paths to avoid
+// depending on the packages and to avoid matching unrelated errors with the
+// same names.
+func findResultCode(err error) (code int, ok bool) {
+	errcode.Walk(err, func(err error) bool {
+		code, ok = resultCode(err)
+		return !ok
+	})
+	return code, ok
+}
+
+func resultCode(err error) (int, bool) {
+	t := reflect.TypeOf(err)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Name() != "Error" {
+		return 0, false
+	}
+	switch t.PkgPath() {
+	case "modernc.org/sqlite":
+		if e, ok := err.(moderncError); ok {
+			return e.Code(), true
+		}
+	case "github.com/mattn/go-sqlite3":
+		// A sqlite3.Error is a struct whose ExtendedCode field has an integer type.
+		v := reflect.Indirect(reflect.ValueOf(err))
+		if v.Kind() != reflect.Struct {
+			return 0, false
+		}
+		if f := v.FieldByName("ExtendedCode"); f.IsValid() && f.CanInt() {
+			return int(f.Int()), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package sqliteerr
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+	_ "modernc.org/sqlite"
+)
+
+// testDriver checks the codes of errors returned by the driver with the given name.
+func testDriver(t *testing.T, driver string) {
+	t.Helper()
+	db, err := sql.Open(driver, ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT UNIQUE NOT NULL, age INTEGER CHECK (age >= 0))`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO users (id, name) VALUES (1, 'alice')`); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		query string
+		want  codes.Code
+	}{
+		{`INSERT INTO users (id, name) VALUES (2, 'alice')`, codes.AlreadyExists},
+		{`INSERT INTO users (id, name) VALUES (1, 'bob')`, codes.AlreadyExists},
+		{`INSERT INTO users (id, name) VALUES (3, NULL)`, codes.InvalidArgument},
+		{`INSERT INTO users (id, name, age) VALUES (4, 'carol', -1)`, codes.InvalidArgument},
+		{`SELECT * FROM missing`, codes.Unknown},
+	}
+	for _, tt := range tests {
+		_, err := db.Exec(tt.query)
+		if err == nil {
+			t.Fatalf("Exec(%q): expected error", tt.query)
+		}
+		if got := ErrorCode(fmt.Errorf("exec: %w", err)); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", err, got, tt.want)
+		}
+	}
+}
+
+func TestModernc(t *testing.T) {
+	testDriver(t, "sqlite")
+}
+
+// Error has the same name as the drivers' error types, but a different package.
+type Error struct{ ExtendedCode int }
+
+func (Error) Error() string { return "not sqlite" }
+func (Error) Code() int     { return 2067 }
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{errors.New("boom"), codes.Unknown},
+		{Error{ExtendedCode: 2067}, codes.Unknown},
+		{&Error{ExtendedCode: 2067}, codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestResultCode(t *testing.T) {
+	tests := []struct {
+		code int
+		want codes.Code
+	}{
+		{5, codes.Aborted},          // SQLITE_BUSY
+		{261, codes.Aborted},        // SQLITE_BUSY_RECOVERY
+		{2067, codes.AlreadyExists}, // SQLITE_CONSTRAINT_UNIQUE
+		{19, codes.FailedPrecondition},
+		{1, codes.Unknown}, // SQLITE_ERROR
+	}
+	for _, tt := range tests {
+		if got := ResultCode(tt.code); got != tt.want {
+			t.Errorf("ResultCode(%d): got %v; want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY)`); err != nil {
+		t.Fatal(err)
+	}
+	_, unique := db.Exec(`INSERT INTO users (id) VALUES (1), (1)`)
+	_, missing := db.Exec(`SELECT * FROM missing`)
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "primary key", Err: unique, Want: codes.AlreadyExists},
+		{Name: "missing table", Err: missing, Want: codes.Unknown},
+		{Name: "other package", Err: &Error{ExtendedCode: 2067}, Want: codes.Unknown},
+	})
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package sqlstate provides the ability to map SQLSTATE error codes to status codes.
+//
+// A SQLSTATE is a five character code defined by the SQL standard. The first two
+// characters are its class and the last three are its subclass. The standard mapping
+// covers the classes and codes defined by the SQL standard and ODBC, which vendor
+// specific coders can extend with a Mapping.
+package sqlstate
+
+import (
+	"maps"
+	"strings"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+// An Error is an error with a SQLSTATE, such as *pgconn.PgError
+// from github.com/jackc/pgx/v5 or *pq.Error from github.com/lib/pq.
+type Error interface {
+	SQLState() string
+	error
+}
+
+// SEE: https://en.wikipedia.org/wiki/SQLSTATE
+// SEE: https://learn.microsoft.com/en-us/sql/odbc/reference/appendixes/appendix-a-odbc-error-codes
+
+var standardCodes = map[string]codes.Code{
+	"02000": codes.NotFound, // no data
+
+	"22003": codes.OutOfRange, // numeric value out of range
+	"22008": codes.OutOfRange, // datetime field overflow
+
+	"23505": codes.AlreadyExists, // unique violation (de facto)
+
+	"40001": codes.Aborted, // serialization failure
+	"40002": codes.Aborted, // integrity constraint violation
+	// NOTE: The transaction may or may not have committed, so it must not be treated as Aborted.
+	"40003": codes.Unknown, // statement completion unknown
+
+	"42S01": codes.AlreadyExists,   // base table or view already exists
+	"42S02": codes.NotFound,        // base table or view not found
+	"42S11": codes.AlreadyExists,   // index already exists
+	"42S12": codes.NotFound,        // index not found
+	"42S21": codes.AlreadyExists,   // column already exists
+	"42S22": codes.InvalidArgument, // column not found
+
+	"HY008": codes.Canceled,         // operation canceled
+	"HYT00": codes.DeadlineExceeded, // timeout expired
+	"HYT01": codes.DeadlineExceeded, // connection timeout expired
+}
+
+var standardClasses = map[string]codes.Code{
+	"08": codes.Unavailable,        // connection exception
+	"0A": codes.Unimplemented,      // feature not supported
+	"0B": codes.FailedPrecondition, // invalid transaction initiation
+	"0L": codes.PermissionDenied,   // invalid grantor
+	"0P": codes.InvalidArgument,    // invalid role specification
+	"21": codes.InvalidArgument,    // cardinality violation
+	"22": codes.InvalidArgument,    // data exception
+	"23": codes.FailedPrecondition, // integrity constraint violation
+	"24": codes.FailedPrecondition, // invalid cursor state
+	"25": codes.FailedPrecondition, // invalid transaction state
+	"26": codes.NotFound,           // invalid SQL statement name
+	"27": codes.FailedPrecondition, // triggered data change violation
+	"28": codes.Unauthenticated,    // invalid authorization specification
+	"2B": codes.FailedPrecondition, // dependent privilege descriptors still exist
+	"2D": codes.FailedPrecondition, // invalid transaction termination
+	"34": codes.NotFound,           // invalid cursor name
+	"3D": codes.NotFound,           // invalid catalog name
+	"3F": codes.NotFound,           // invalid schema name
+	"40": codes.Aborted,            // transaction rollback
+	"42": codes.InvalidArgument,    // syntax error or access rule violation
+	"44": codes.InvalidArgument,    // with check option violation
+}
+
+var standard = &Mapping{codes: standardCodes, classes: standardClasses}
+
+// A Mapping maps SQLSTATEs to codes.
+type Mapping struct {
+	codes   map[string]codes.Code
+	classes map[string]codes.Code
+}
+
+// Standard returns the standard Mapping.
+func Standard() *Mapping {
+	return standard
+}
+
+// Extend returns a new Mapping that overrides the Mapping with the given codes.
+// Each key must be either a five character SQLSTATE or a two character class.
+// An exact SQLSTATE takes precedence over its class in both mappings.
+func (m *Mapping) Extend(overrides map[string]codes.Code) *Mapping {
+	x := &Mapping{
+		codes:   maps.Clone(m.codes),
+		classes: maps.Clone(m.classes),
+	}
+	for k, v := range overrides {
+		k = strings.ToUpper(k)
+		switch len(k) {
+		case 2:
+			x.classes[k] = v
+		case 5:
+			x.codes[k] = v
+		default:
+			panic("sqlstate: invalid SQLSTATE or class: " + k)
+		}
+	}
+	return x
+}
+
+// Code returns the gRPC code associated with the given SQLSTATE.
+func (m *Mapping) Code(state string) codes.Code {
+	if len(state) != 5 {
+		return codes.Unknown
+	}
+	state = strings.ToUpper(state)
+	if code, ok := m.codes[state]; ok {
+		return code
+	}
+	if code, ok := m.classes[state[:2]]; ok {
+		return code
+	}
+	return codes.Unknown
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it implements the sqlstate.Error interface.
+func (m *Mapping) ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[Error](err); ok {
+		return m.Code(e.SQLState())
+	}
+	return codes.Unknown
+}
+
+// ErrorCoder returns the standard SQLSTATE ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return standard
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it implements the sqlstate.Error interface, using the standard Mapping.
+func ErrorCode(err error) codes.Code {
+	return standard.ErrorCode(err)
+}
+
+// Code returns the gRPC code associated with the given SQLSTATE,
+// using the standard Mapping.
+func Code(state string) codes.Code {
+	return standard.Code(state)
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package sqlstate
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMapping(t *testing.T) {
+	m := Standard().Extend(map[string]codes.Code{
+		"53":    codes.ResourceExhausted,
+		"40001": codes.Unavailable,
+	})
+	tests := []struct {
+		state     string
+		standard  codes.Code
+		overrides codes.Code
+	}{
+		{"23505", codes.AlreadyExists, codes.AlreadyExists},
+		{"23503", codes.FailedPrecondition, codes.FailedPrecondition},
+		{"40001", codes.Aborted, codes.Unavailable},
+		{"40P01", codes.Aborted, codes.Aborted},
+		{"53200", codes.Unknown, codes.ResourceExhausted},
+		{"hyt00", codes.DeadlineExceeded, codes.DeadlineExceeded},
+		{"4000", codes.Unknown, codes.Unknown},
+		{"", codes.Unknown, codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := Code(tt.state); got != tt.standard {
+			t.Errorf("Code(%q): got %v; want %v", tt.state, got, tt.standard)
+		}
+		if got := m.Code(tt.state); got != tt.overrides {
+			t.Errorf("Extend(...).Code(%q): got %v; want %v", tt.state, got, tt.overrides)
+		}
+	}
+}
+
+type stateError string
+
+func (e stateError) Error() string    { return "sqlstate " + string(e) }
+func (e stateError) SQLState() string { return string(e) }
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "unique violation", Err: stateError("23505"), Want: codes.AlreadyExists},
+		{Name: "serialization failure", Err: stateError("40001"), Want: codes.Aborted},
+		{Name: "unrecognized state", Err: stateError("53200"), Want: codes.Unknown},
+	})
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package tlserr provides the ability to extract the status code from errors
+// from the crypto/tls and crypto/x509 packages.
+//
+// Failures to verify a peer's identity are mapped to Unauthenticated.
+// Certificates that are valid but not permitted for their use are mapped to PermissionDenied.
+// Other handshake failures, such as a peer that doesn't speak TLS, are mapped to Unavailable.
+//
+// Alerts sent by the peer are reported by the crypto/tls package as a *net.OpError
+// with the "remote error" operation, whose error has an unexported type, so they're
+// recognized by their messages, such as "remote error: tls: bad certificate".
+package tlserr
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"strings"
+
+	"bursavich.dev/errcode"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the TLS ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// TLS alert descriptions.
+// SEE: https://www.rfc-editor.org/rfc/rfc8446#section-6
+const (
+	alertBadCertificate         tls.AlertError = 42
+	alertUnsupportedCertificate tls.AlertError = 43
+	alertCertificateRevoked     tls.AlertError = 44
+	alertCertificateExpired     tls.AlertError = 45
+	alertCertificateUnknown     tls.AlertError = 46
+	alertUnknownCA              tls.AlertError = 48
+	alertAccessDenied           tls.AlertError = 49
+	alertCertificateRequired    tls.AlertError = 116
+)
+
+// remoteAlerts maps the messages of the alerts to their descriptions.
+var remoteAlerts = func() map[string]tls.AlertError {
+	m := make(map[string]tls.AlertError)
+	for _, a := range []tls.AlertError{
+		alertBadCertificate,
+		alertUnsupportedCertificate,
+		alertCertificateRevoked,
+		alertCertificateExpired,
+		alertCertificateUnknown,
+		alertUnknownCA,
+		alertAccessDenied,
+		alertCertificateRequired,
+	} {
+		m[a.Error()] = a
+	}
+	return m
+}()
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a TLS or X.509 error.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[x509.CertificateInvalidError](err); ok {
+		switch e.Reason {
+		case x509.NotAuthorizedToSign,
+			x509.CANotAuthorizedForThisName,
+			x509.CANotAuthorizedForExtKeyUsage,
+			x509.IncompatibleUsage:
+			return codes.PermissionDenied
+		}
+		return codes.Unauthenticated
+	}
+	if _, ok := errcode.As[x509.ConstraintViolationError](err); ok {
+		return codes.PermissionDenied
+	}
+	if _, ok := errcode.As[x509.UnknownAuthorityError](err); ok {
+		return codes.Unauthenticated
+	}
+	if _, ok := errcode.As[x509.HostnameError](err); ok {
+		return codes.Unauthenticated
+	}
+	if _, ok := errcode.As[x509.InsecureAlgorithmError](err); ok {
+		return codes.Unauthenticated
+	}
+	if _, ok := errcode.As[*tls.CertificateVerificationError](err); ok {
+		return codes.Unauthenticated
+	}
+	if _, ok := errcode.As[x509.SystemRootsError](err); ok {
+		return codes.Internal
+	}
+	if e, ok := errcode.As[tls.AlertError](err); ok {
+		return alertCode(e)
+	}
+	if e, ok := errcode.As[*net.OpError](err); ok && e.Op == "remote error" && e.Err != nil {
+		// Other alerts, such as "tls: handshake failure", aren't in the map.
+		if msg := e.Err.Error(); strings.HasPrefix(msg, "tls: ") {
+			return alertCode(remoteAlerts[msg])
+		}
+	}
+	if _, ok := errcode.As[tls.RecordHeaderError](err); ok {
+		return codes.Unavailable
+	}
+	if _, ok := errcode.As[*tls.ECHRejectionError](err); ok {
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+func alertCode(a tls.AlertError) codes.Code {
+	switch a {
+	case alertBadCertificate,
+		alertUnsupportedCertificate,
+		alertCertificateRevoked,
+		alertCertificateExpired,
+		alertCertificateUnknown,
+		alertUnknownCA,
+		alertCertificateRequired:
+		return codes.Unauthenticated
+	case alertAccessDenied:
+		return codes.PermissionDenied
+	}
+	return codes.Unavailable
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package tlserr
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	// The crypto/tls package reports alerts from the peer with an unexported
+	// type that has the same message as the corresponding tls.AlertError.
+	type remoteAlert struct{ error }
+	remote := func(a tls.AlertError) error {
+		return fmt.Errorf("read: %w", &net.OpError{Op: "remote error", Err: remoteAlert{errors.New(a.Error())}})
+	}
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{tls.AlertError(42), codes.Unauthenticated},
+		{tls.AlertError(49), codes.PermissionDenied},
+		{tls.AlertError(40), codes.Unavailable},
+		{remote(42), codes.Unauthenticated},
+		{remote(116), codes.Unauthenticated},
+		{remote(49), codes.PermissionDenied},
+		{remote(40), codes.Unavailable},
+		{&net.OpError{Op: "remote error", Err: errors.New("connection reset")}, codes.Unknown},
+		{&net.OpError{Op: "read", Err: errors.New("tls: bad certificate")}, codes.Unknown},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "bad certificate", Err: tls.AlertError(42), Want: codes.Unauthenticated},
+		{Name: "access denied", Err: tls.AlertError(49), Want: codes.PermissionDenied},
+		{Name: "unknown authority", Err: x509.UnknownAuthorityError{}, Want: codes.Unauthenticated},
+		{Name: "hostname", Err: x509.HostnameError{Host: "example.com", Certificate: &x509.Certificate{}}, Want: codes.Unauthenticated},
+		{Name: "record header", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}, Want: codes.Unavailable},
+	})
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"reflect"
+	"slices"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+)
+
+// AllCodes returns every code found in err's tree, in the order in which they're found.
+// It is intended for debugging errors whose inner codes are masked by outer ones.
+//
+// The coders are applied to each error in a pre-order traversal of the tree.
+// The results are deduplicated and exclude Unknown. If err is nil, it returns nil.
+func AllCodes(err error, coders ...ErrorCoder) []codes.Code {
+	coder := Compact(coders...)
+	var list []codes.Code
+	Walk(err, func(err error) bool {
+		if c := coder.ErrorCode(err); c != codes.Unknown && !slices.Contains(list, c) {
+			list = append(list, c)
+		}
+		return true
+	})
+	return list
+}
+
+// defaultMaxDepth is the default maximum depth of traversals.
+const defaultMaxDepth = 100
+
+var maxDepth atomic.Int64
+
+func init() { maxDepth.Store(defaultMaxDepth) }
+
+// SetMaxDepth sets the maximum depth to which the built-in coders and functions,
+// such as AllCodes, As, Is, and Walk, traverse error trees. The total number
+// of errors traversed in a tree is also limited to the square of the depth.
+// Errors beyond the limits are ignored, so pathological trees, such as those with
+// cycles created by buggy Unwrap methods, resolve to Unknown rather than hang.
+//
+// The default depth is 100. It panics if n is less than 1.
+func SetMaxDepth(n int) {
+	if n < 1 {
+		panic("errcode: invalid max depth")
+	}
+	maxDepth.Store(int64(n))
+}
+
+// Walk calls fn for each error in err's tree in pre-order until fn returns false.
+// It reports whether the walk completed. Like errors.As and errors.Is, it follows
+// both Unwrap() error and Unwrap() []error methods. The walk stops early, without
+// calling fn, if it exceeds the limits set by SetMaxDepth.
+func Walk(err error, fn func(error) bool) bool {
+	depth := maxDepth.Load()
+	budget := depth * depth
+	return walkLimited(err, fn, depth, &budget)
+}
+
+// WithinLimits reports whether err's tree is within the limits set by SetMaxDepth.
+// It guards functions that traverse trees without limits, such as those of other
+// packages that use errors.As and errors.Is, so that pathological trees resolve
+// to Unknown rather than hang.
+func WithinLimits(err error) bool {
+	return Walk(err, func(error) bool { return true })
+}
+
+func walkLimited(err error, fn func(error) bool, depth int64, budget *int64) bool {
+	if err == nil {
+		return true
+	}
+	if depth == 0 || *budget == 0 {
+		return false
+	}
+	*budget--
+	if !fn(err) {
+		return false
+	}
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		return walkLimited(x.Unwrap(), fn, depth-1, budget)
+	case interface{ Unwrap() []error }:
+		for _, err := range x.Unwrap() {
+			if !walkLimited(err, fn, depth-1, budget) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// As finds the first error in err's tree that is a T, or whose As method
+// sets a T, and returns it. It's like errors.As, but it's limited by
+// SetMaxDepth and it uses type assertions rather than reflection,
+// so it doesn't allocate unless an error in the tree has an As method.
+func As[T any](err error) (T, bool) {
+	var (
+		found T
+		ok    bool
+	)
+	Walk(err, func(err error) bool {
+		if found, ok = err.(T); ok {
+			return false
+		}
+		if x, isAs := err.(interface{ As(any) bool }); isAs {
+			var e T
+			if x.As(&e) {
+				found, ok = e, true
+				return false
+			}
+		}
+		return true
+	})
+	return found, ok
+}
+
+// Is reports whether any error in err's tree matches target.
+// It's like errors.Is, but it's limited by SetMaxDepth.
+func Is(err, target error) bool {
+	if err == nil || target == nil {
+		return err == target
+	}
+	comparable := reflect.TypeOf(target).Comparable()
+	found := false
+	Walk(err, func(err error) bool {
+		if comparable && err == target {
+			found = true
+		} else if x, ok := err.(interface{ Is(error) bool }); ok && x.Is(target) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package errcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestAllCodes(t *testing.T) {
+	inner := New(codes.NotFound, errors.New("missing"))
+	err := New(codes.Internal, fmt.Errorf("lookup: %w", errors.Join(inner, context.Canceled)))
+	got := AllCodes(err, CodedErrorCoder(), ContextErrorCoder())
+	want := []codes.Code{codes.Internal, codes.NotFound, codes.Canceled}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AllCodes: got %v; want %v", got, want)
+	}
+	if got := AllCodes(nil, CodedErrorCoder()); got != nil {
+		t.Errorf("AllCodes(nil): got %v; want nil", got)
+	}
+}
+
+// asError converts itself to an Error with its code.
+type asError struct{ code codes.Code }
+
+func (e *asError) Error() string { return "as" }
+
+func (e *asError) As(target any) bool {
+	if p, ok := target.(*Error); ok {
+		*p = New(e.code, e).(Error)
+		return true
+	}
+	return false
+}
+
+func TestAs(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+		ok   bool
+	}{
+		{name: "nil"},
+		{name: "plain", err: errors.New("plain")},
+		{name: "direct", err: New(codes.NotFound, errors.New("missing")), want: codes.NotFound, ok: true},
+		{
+			name: "joined",
+			err:  fmt.Errorf("get: %w", errors.Join(errors.New("plain"), New(codes.Aborted, errors.New("conflict")))),
+			want: codes.Aborted,
+			ok:   true,
+		},
+		{name: "As method", err: fmt.Errorf("get: %w", &asError{codes.Internal}), want: codes.Internal, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := As[Error](tt.err)
+			if ok != tt.ok || (ok && e.Code() != tt.want) {
+				t.Errorf("as: got (%v, %v); want (%v, %v)", e, ok, tt.want, tt.ok)
+			}
+			if want := (Error)(nil); errors.As(tt.err, &want) != ok || (ok && want.Code() != e.Code()) {
+				t.Errorf("as: got (%v, %v); want errors.As result (%v)", e, ok, want)
+			}
+		})
+	}
+}
+
+// cycleError is a buggy error whose Unwrap method returns itself.
+type cycleError struct{}
+
+func (e *cycleError) Error() string { return "cycle" }
+func (e *cycleError) Unwrap() error { return e }
+
+// joinCycleError is a buggy error whose Unwrap method returns itself twice.
+type joinCycleError struct{}
+
+func (e *joinCycleError) Error() string   { return "join cycle" }
+func (e *joinCycleError) Unwrap() []error { return []error{e, e} }
+
+func TestCycles(t *testing.T) {
+	coder := Compact(
+		CodedErrorCoder(),
+		ContextErrorCoder(),
+		FileSystemErrorCoder(),
+		TransientErrorCoder(),
+		IOErrorCoder(),
+		MapErrors(map[error]codes.Code{errors.ErrUnsupported: codes.Unimplemented}),
+		MapType[*fs.PathError](codes.NotFound),
+	)
+	for _, err := range []error{&cycleError{}, &joinCycleError{}} {
+		if got := coder.ErrorCode(err); got != codes.Unknown {
+			t.Errorf("ErrorCode(%v): got %v; want %v", err, got, codes.Unknown)
+		}
+		if got := AllCodes(err, coder); got != nil {
+			t.Errorf("AllCodes(%v): got %v; want nil", err, got)
+		}
+		if _, ok := RetryDelay(err); ok {
+			t.Errorf("RetryDelay(%v): got a delay", err)
+		}
+		if _, ok := As[*fs.PathError](err); ok {
+			t.Errorf("As(%v): got a match", err)
+		}
+		if Is(err, io.EOF) {
+			t.Errorf("Is(%v): got a match", err)
+		}
+		if Walk(err, func(error) bool { return true }) {
+			t.Errorf("Walk(%v): got a complete walk", err)
+		}
+	}
+}
+
+// eofError is an error that matches io.EOF.
+type eofError struct{}
+
+func (eofError) Error() string        { return "eof" }
+func (eofError) Is(target error) bool { return target == io.EOF }
+
+// sliceError is an error that isn't comparable.
+type sliceError []string
+
+func (e sliceError) Error() string { return strings.Join(e, ", ") }
+
+func TestIs(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{name: "nil", want: true},
+		{name: "nil target", err: io.EOF},
+		{name: "nil error", target: io.EOF},
+		{name: "match", err: io.EOF, target: io.EOF, want: true},
+		{name: "wrapped", err: fmt.Errorf("read: %w", io.EOF), target: io.EOF, want: true},
+		{name: "joined", err: errors.Join(io.ErrUnexpectedEOF, io.EOF), target: io.EOF, want: true},
+		{name: "is method", err: eofError{}, target: io.EOF, want: true},
+		{name: "mismatch", err: io.ErrUnexpectedEOF, target: io.EOF},
+		{name: "non-comparable", err: sliceError{"a"}, target: sliceError{"a"}},
+		{name: "non-comparable error", err: fmt.Errorf("x: %w", sliceError{"a"}), target: io.EOF},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("Is: got %v; want %v", got, tt.want)
+			}
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is: got %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetMaxDepth(t *testing.T) {
+	t.Cleanup(func() { SetMaxDepth(defaultMaxDepth) })
+	err := fmt.Errorf("a: %w", fmt.Errorf("b: %w", New(codes.NotFound, context.Canceled)))
+
+	SetMaxDepth(3)
+	if got := CodedErrorCoder().ErrorCode(err); got != codes.NotFound {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.NotFound)
+	}
+	if got := ContextErrorCoder().ErrorCode(err); got != codes.Unknown {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.Unknown)
+	}
+
+	SetMaxDepth(2)
+	if got := CodedErrorCoder().ErrorCode(err); got != codes.Unknown {
+		t.Errorf("ErrorCode: got %v; want %v", got, codes.Unknown)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SetMaxDepth(0): expected a panic")
+		}
+	}()
+	SetMaxDepth(0)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package twirperr provides the ability to extract the status code from Twirp errors
+// from the github.com/twitchtv/twirp package.
+package twirperr
+
+import (
+	"context"
+
+	"bursavich.dev/errcode"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Twirp ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a twirp.Error. A twirp.Error with twirp.NoError
+// is Unknown, because a non-nil error is never OK.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[twirp.Error](err); ok {
+		if code := ToGRPC(e.Code()); code != codes.OK {
+			return code
+		}
+	}
+	return codes.Unknown
+}
+
+// SEE: https://twitchtv.github.io/twirp/docs/spec_v7.html#error-codes
+
+var twirpCodes = map[twirp.ErrorCode]codes.Code{
+	twirp.NoError:            codes.OK,
+	twirp.Canceled:           codes.Canceled,
+	twirp.Unknown:            codes.Unknown,
+	twirp.InvalidArgument:    codes.InvalidArgument,
+	twirp.Malformed:          codes.InvalidArgument, // the client sent a message that couldn't be decoded
+	twirp.DeadlineExceeded:   codes.DeadlineExceeded,
+	twirp.NotFound:           codes.NotFound,
+	twirp.BadRoute:           codes.Unimplemented, // the requested URL path wasn't routable to a method
+	twirp.AlreadyExists:      codes.AlreadyExists,
+	twirp.PermissionDenied:   codes.PermissionDenied,
+	twirp.ResourceExhausted:  codes.ResourceExhausted,
+	twirp.FailedPrecondition: codes.FailedPrecondition,
+	twirp.Aborted:            codes.Aborted,
+	twirp.OutOfRange:         codes.OutOfRange,
+	twirp.Unimplemented:      codes.Unimplemented,
+	twirp.Internal:           codes.Internal,
+	twirp.Unavailable:        codes.Unavailable,
+	twirp.DataLoss:           codes.DataLoss,
+	twirp.Unauthenticated:    codes.Unauthenticated,
+}
+
+var grpcCodes = map[codes.Code]twirp.ErrorCode{
+	codes.OK:                 twirp.NoError,
+	codes.Canceled:           twirp.Canceled,
+	codes.Unknown:            twirp.Unknown,
+	codes.InvalidArgument:    twirp.InvalidArgument,
+	codes.DeadlineExceeded:   twirp.DeadlineExceeded,
+	codes.NotFound:           twirp.NotFound,
+	codes.AlreadyExists:      twirp.AlreadyExists,
+	codes.PermissionDenied:   twirp.PermissionDenied,
+	codes.ResourceExhausted:  twirp.ResourceExhausted,
+	codes.FailedPrecondition: twirp.FailedPrecondition,
+	codes.Aborted:            twirp.Aborted,
+	codes.OutOfRange:         twirp.OutOfRange,
+	codes.Unimplemented:      twirp.Unimplemented,
+	codes.Internal:           twirp.Internal,
+	codes.Unavailable:        twirp.Unavailable,
+	codes.DataLoss:           twirp.DataLoss,
+	codes.Unauthenticated:    twirp.Unauthenticated,
+}
+
+// ToGRPC returns the gRPC code associated with the given Twirp error code.
+// It returns codes.Unknown if the Twirp error code isn't recognized.
+func ToGRPC(code twirp.ErrorCode) codes.Code {
+	if c, ok := twirpCodes[code]; ok {
+		return c
+	}
+	return codes.Unknown
+}
+
+// FromGRPC returns the Twirp error code associated with the given gRPC code.
+// It returns twirp.Unknown if the gRPC code isn't recognized.
+func FromGRPC(code codes.Code) twirp.ErrorCode {
+	if c, ok := grpcCodes[code]; ok {
+		return c
+	}
+	return twirp.Unknown
+}
+
+// Interceptor returns a server interceptor that converts errors returned
+// by methods into Twirp errors with the code resolved by the given ErrorCoder.
+// The message of a Twirp error is the error's public message, if it has one.
+// Errors that already contain a twirp.Error are returned unchanged.
+// Without it, Twirp reports every other error as Internal.
+//
+// The ErrorCoder is installed in the method's context and may be retrieved
+// with errcode.FromContext.
+//
+// It is installed with twirp.WithServerInterceptors.
+func Interceptor(coder errcode.ErrorCoder) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req any) (any, error) {
+			resp, err := next(errcode.NewContext(ctx, coder), req)
+			if err == nil {
+				return resp, nil
+			}
+			if _, ok := errcode.As[twirp.Error](err); ok {
+				return resp, err
+			}
+			code := FromGRPC(errcode.ResolveError(coder, err))
+			msg, ok := errcode.PublicMessage(err)
+			if !ok {
+				msg = err.Error()
+			}
+			return resp, twirp.WrapError(twirp.NewError(code, msg), err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package twirperr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc/codes"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{twirp.NotFoundError("missing"), codes.NotFound},
+		{fmt.Errorf("call: %w", twirp.NewError(twirp.Malformed, "bad json")), codes.InvalidArgument},
+		{twirp.NewError(twirp.BadRoute, "no route"), codes.Unimplemented},
+		{twirp.NewError(twirp.NoError, "ok?"), codes.Unknown},
+		{errors.New("boom"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := ErrorCode(tt.err); got != tt.want {
+			t.Errorf("ErrorCode(%v): got %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "not found", Err: twirp.NotFoundError("missing"), Want: codes.NotFound},
+		{Name: "malformed", Err: twirp.NewError(twirp.Malformed, "bad json"), Want: codes.InvalidArgument},
+		{Name: "no error", Err: twirp.NewError(twirp.NoError, "ok?"), Want: codes.Unknown},
+	})
+}
+
+func TestCodes(t *testing.T) {
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if got := ToGRPC(FromGRPC(code)); got != code {
+			t.Errorf("ToGRPC(FromGRPC(%v)): got %v", code, got)
+		}
+	}
+	if got := ToGRPC(twirp.ErrorCode("bogus")); got != codes.Unknown {
+		t.Errorf("ToGRPC(bogus): got %v; want %v", got, codes.Unknown)
+	}
+	if got := FromGRPC(codes.Code(99)); got != twirp.Unknown {
+		t.Errorf("FromGRPC(99): got %v; want %v", got, twirp.Unknown)
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	coder := errcode.FileSystemErrorCoder()
+	tests := []struct {
+		name string
+		err  error
+		code twirp.ErrorCode
+		msg  string
+	}{
+		{name: "nil"},
+		{
+			name: "coded",
+			err:  fmt.Errorf("open: %w", fs.ErrNotExist),
+			code: twirp.NotFound,
+			msg:  "open: file does not exist",
+		},
+		{
+			name: "twirp",
+			err:  fmt.Errorf("update: %w", twirp.NewError(twirp.Aborted, "conflict")),
+			code: twirp.Aborted,
+			msg:  "conflict",
+		},
+		{
+			name: "public",
+			err:  errcode.WithPublicMessage(fmt.Errorf("open /etc/secret: %w", fs.ErrPermission), "access denied"),
+			code: twirp.PermissionDenied,
+			msg:  "access denied",
+		},
+		{
+			name: "unknown",
+			err:  errors.New("boom"),
+			code: twirp.Unknown,
+			msg:  "boom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cause := tt.err
+			method := Interceptor(coder)(func(ctx context.Context, _ any) (any, error) {
+				if got, ok := errcode.FromContext(ctx); !ok || got != coder {
+					t.Errorf("FromContext: got %v, %v; want %v, true", got, ok, coder)
+				}
+				return nil, cause
+			})
+			_, err := method(context.Background(), nil)
+			if tt.err == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var e twirp.Error
+			if !errors.As(err, &e) {
+				t.Fatalf("error is not a twirp error: %v", err)
+			}
+			if e.Code() != tt.code {
+				t.Errorf("code: got %v; want %v", e.Code(), tt.code)
+			}
+			if e.Msg() != tt.msg {
+				t.Errorf("message: got %q; want %q", e.Msg(), tt.msg)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error chain doesn't contain the cause: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package vaulterr provides the ability to extract the status code from HashiCorp Vault errors
+// from the github.com/hashicorp/vault/api package.
+package vaulterr
+
+import (
+	"strings"
+
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	"github.com/hashicorp/vault/api"
+	"google.golang.org/grpc/codes"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the Vault ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+// SEE: https://developer.hashicorp.com/vault/api-docs#http-status-codes
+
+// Vault reports some conditions with generic status codes, so well-known messages
+// are matched first. The first matching message determines the code.
+var messageCodes = []struct {
+	contains string
+	code     codes.Code
+}{
+	{"missing client token", codes.Unauthenticated},
+	{"permission denied", codes.PermissionDenied},
+	{"rate limit quota exceeded", codes.ResourceExhausted},
+	{"Vault is sealed", codes.Unavailable},
+}
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains an *api.ResponseError or api.ErrSecretNotFound.
+// Unknown messages fall back to the HTTP status code.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*api.ResponseError](err); ok {
+		for _, msg := range e.Errors {
+			for _, m := range messageCodes {
+				if strings.Contains(msg, m.contains) {
+					return m.code
+				}
+			}
+		}
+		return httperr.ToGRPC(e.StatusCode)
+	}
+	if errcode.Is(err, api.ErrSecretNotFound) {
+		return codes.NotFound
+	}
+	return codes.Unknown
+}
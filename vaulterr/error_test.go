@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package vaulterr
+
+import (
+	"net/http"
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	"github.com/hashicorp/vault/api"
+	"google.golang.org/grpc/codes"
+)
+
+func responseError(status int, errs ...string) error {
+	return &api.ResponseError{
+		HTTPMethod: http.MethodGet,
+		URL:        "https://vault.example.com/v1/secret/data/app",
+		StatusCode: status,
+		Errors:     errs,
+	}
+}
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "permission denied", Err: responseError(http.StatusForbidden, "1 error occurred:\n\t* permission denied\n\n"), Want: codes.PermissionDenied},
+		{Name: "missing token", Err: responseError(http.StatusBadRequest, "missing client token"), Want: codes.Unauthenticated},
+		{Name: "rate limit", Err: responseError(http.StatusTooManyRequests, "request path \"secret/\": rate limit quota exceeded"), Want: codes.ResourceExhausted},
+		{Name: "sealed", Err: responseError(http.StatusServiceUnavailable, "Vault is sealed"), Want: codes.Unavailable},
+		{Name: "http status", Err: responseError(http.StatusNotFound), Want: codes.NotFound},
+		{Name: "secret not found", Err: api.ErrSecretNotFound, Want: codes.NotFound},
+	})
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package websocketerr provides the ability to extract the status code from WebSocket errors
+// from the github.com/gorilla/websocket, github.com/coder/websocket, and nhooyr.io/websocket
+// packages. Close codes are mapped by httperr.WebSocketToGRPC.
+package websocketerr
+
+import (
+	"bursavich.dev/errcode"
+	"bursavich.dev/errcode/httperr"
+	coder "github.com/coder/websocket"
+	gorilla "github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	nhooyr "nhooyr.io/websocket"
+)
+
+var errorCoder errcode.ErrorCoder = errcode.FromFunc(ErrorCode)
+
+// ErrorCoder returns the WebSocket ErrorCoder.
+func ErrorCoder() errcode.ErrorCoder {
+	return errorCoder
+}
+
+var errorCodes = map[error]codes.Code{
+	gorilla.ErrReadLimit: codes.ResourceExhausted,
+	gorilla.ErrCloseSent: codes.FailedPrecondition,
+}
+
+var sentinelCoder = errcode.MapErrors(errorCodes)
+
+// ErrorCode returns the gRPC code associated with the given error
+// if it contains a WebSocket close error or a known WebSocket error.
+// A close error with the Normal Closure code is Unknown, because
+// a non-nil error is never OK.
+func ErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := errcode.As[*gorilla.CloseError](err); ok {
+		return closeCode(e.Code)
+	}
+	if e, ok := errcode.As[coder.CloseError](err); ok {
+		return closeCode(int(e.Code))
+	}
+	if e, ok := errcode.As[nhooyr.CloseError](err); ok {
+		return closeCode(int(e.Code))
+	}
+	return sentinelCoder.ErrorCode(err)
+}
+
+func closeCode(closeCode int) codes.Code {
+	if code := httperr.WebSocketToGRPC(closeCode); code != codes.OK {
+		return code
+	}
+	return codes.Unknown
+}
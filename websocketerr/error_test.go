@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package websocketerr
+
+import (
+	"testing"
+
+	"bursavich.dev/errcode/errcodetest"
+	coder "github.com/coder/websocket"
+	gorilla "github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	nhooyr "nhooyr.io/websocket"
+)
+
+func TestErrorCoder(t *testing.T) {
+	errcodetest.TestErrorCoder(t, ErrorCoder(), []errcodetest.Case{
+		{Name: "gorilla normal closure", Err: &gorilla.CloseError{Code: gorilla.CloseNormalClosure}, Want: codes.Unknown},
+		{Name: "gorilla going away", Err: &gorilla.CloseError{Code: gorilla.CloseGoingAway}, Want: codes.Unavailable},
+		{Name: "gorilla message too big", Err: &gorilla.CloseError{Code: gorilla.CloseMessageTooBig}, Want: codes.ResourceExhausted},
+		{Name: "gorilla application", Err: &gorilla.CloseError{Code: 4404}, Want: codes.NotFound},
+		{Name: "gorilla read limit", Err: gorilla.ErrReadLimit, Want: codes.ResourceExhausted},
+		{Name: "gorilla close sent", Err: gorilla.ErrCloseSent, Want: codes.FailedPrecondition},
+		{Name: "coder normal closure", Err: coder.CloseError{Code: coder.StatusNormalClosure}, Want: codes.Unknown},
+		{Name: "coder policy violation", Err: coder.CloseError{Code: coder.StatusPolicyViolation}, Want: codes.PermissionDenied},
+		{Name: "nhooyr normal closure", Err: nhooyr.CloseError{Code: nhooyr.StatusNormalClosure}, Want: codes.Unknown},
+		{Name: "nhooyr internal error", Err: nhooyr.CloseError{Code: nhooyr.StatusInternalError}, Want: codes.Internal},
+	})
+}
@@ -0,0 +1,21 @@
+module bursavich.dev/errcode/websocketerr
+
+go 1.24.0
+
+require (
+	bursavich.dev/errcode v0.2.0
+	github.com/coder/websocket v1.8.14
+	github.com/gorilla/websocket v1.5.3
+	google.golang.org/grpc v1.72.2
+	nhooyr.io/websocket v1.8.17
+)
+
+require (
+	golang.org/x/net v0.35.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
+	google.golang.org/protobuf v1.36.6 // indirect
+)
+
+replace bursavich.dev/errcode => ../
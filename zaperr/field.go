@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package zaperr provides the ability to log the codes of errors
+// with the go.uber.org/zap package.
+package zaperr
+
+import (
+	"maps"
+	"slices"
+
+	"bursavich.dev/errcode"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Keys of the fields returned by Fields.
+const (
+	CodeKey      = "code"
+	CodeNameKey  = "code_name"
+	RetryableKey = "retryable"
+	MetadataKey  = "metadata"
+)
+
+// Fields returns fields that describe the error: its code resolved by the
+// coders, the code's canonical name, such as "NOT_FOUND", whether it's
+// retryable, and its metadata, if it has any. An error is retryable if its
+// code is retryable, as reported by errcode.RetryableCode, or if it reports
+// a retry delay, as reported by errcode.RetryDelay. The metadata is an object
+// of the error's key-value pairs, as reported by errcode.Metadata.
+//
+// If the error is nil, it returns nil.
+func Fields(err error, coders ...errcode.ErrorCoder) []zap.Field {
+	if err == nil {
+		return nil
+	}
+	code := errcode.Compact(coders...).ErrorCode(err)
+	_, delayed := errcode.RetryDelay(err)
+	fields := []zap.Field{
+		zap.Uint32(CodeKey, uint32(code)),
+		zap.String(CodeNameKey, errcode.CodeString(code)),
+		zap.Bool(RetryableKey, errcode.RetryableCode(code) || delayed),
+	}
+	if md := errcode.Metadata(err); len(md) > 0 {
+		fields = append(fields, zap.Object(MetadataKey, metadata(md)))
+	}
+	return fields
+}
+
+type metadata map[string]string
+
+func (md metadata) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, k := range slices.Sorted(maps.Keys(md)) {
+		enc.AddString(k, md[k])
+	}
+	return nil
+}
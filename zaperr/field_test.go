@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2025 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package zaperr
+
+import (
+	"errors"
+	"testing"
+
+	"bursavich.dev/errcode"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+)
+
+func TestFields(t *testing.T) {
+	if got := Fields(nil); got != nil {
+		t.Errorf("nil: got %v; want nil", got)
+	}
+	core, logs := observer.New(zap.InfoLevel)
+	err := errcode.New(codes.ResourceExhausted, errors.New("slow down"))
+	zap.New(core).Error("failed", Fields(err, errcode.CodedErrorCoder())...)
+
+	got := logs.All()[0].ContextMap()
+	want := map[string]any{
+		CodeKey:      uint32(codes.ResourceExhausted),
+		CodeNameKey:  "RESOURCE_EXHAUSTED",
+		RetryableKey: true,
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %v (%T); want %v (%T)", k, got[k], got[k], v, v)
+		}
+	}
+}
+
+func TestFieldsMetadata(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	err := errcode.WithRequestID(errors.New("boom"), "req-1")
+	zap.New(core).Error("failed", Fields(err)...)
+
+	got, ok := logs.All()[0].ContextMap()[MetadataKey].(map[string]any)
+	if !ok || got[errcode.RequestIDKey] != "req-1" {
+		t.Errorf("%s: got %v", MetadataKey, logs.All()[0].ContextMap()[MetadataKey])
+	}
+}